@@ -27,8 +27,17 @@ func NewAssembler() *Assembler {
 }
 
 func (self *Assembler) Assemble(astNode bashAst.IStatement) error {
+	return self.assembleToFile(astNode, config.Filename+".sh")
+}
+
+// Writes the given Bash completion to a file next to the generated script
+func (self *Assembler) AssembleCompletion(astNode bashAst.IStatement) error {
+	return self.assembleToFile(astNode, config.Filename+".completion.sh")
+}
+
+func (self *Assembler) assembleToFile(astNode bashAst.IStatement, outputFilename string) error {
 	if config.Filename != "" && !self.testMode {
-		self.outputFilename = config.Filename + ".sh"
+		self.outputFilename = outputFilename
 		f, err := os.Create(self.outputFilename)
 		if err != nil {
 			fmt.Println("Something went wrong creating the output file:", err)
@@ -70,10 +79,14 @@ func (self *Assembler) assemble(astNode bashAst.IStatement) error {
 		return self.evalFuncDeclaration(bashAst.StmtToFuncDeclaration(astNode))
 	case bashAst.IfStmtNode:
 		return self.evalIfStmt(bashAst.StmtToIfStmt(astNode))
+	case bashAst.PipelineNode:
+		return self.evalPipeline(bashAst.StmtToPipeline(astNode))
 	case bashAst.ProgramNode:
 		return self.evalProgram(bashAst.StmtToProgram(astNode))
 	case bashAst.ReturnExprNode:
 		return self.evalReturnExpr(astNode)
+	case bashAst.WhileReadStmtNode:
+		return self.evalWhileReadStmt(bashAst.StmtToWhileReadStmt(astNode))
 	case bashAst.WhileStmtNode:
 		return self.evalWhileStmt(bashAst.StmtToWhileStmt(astNode))
 	default:
@@ -77,12 +77,419 @@ func Example_for() {
 	// 	echo "${i}"
 	// done
 	// is=(14 15)
-	// for i in ${is[@]}
+	// for i in "${is[@]}"
 	// do
 	// 	echo "${i}"
 	// done
 }
 
+func Example_forWithBreakAndContinue() {
+	initTestForPrintMode()
+	transpileTest(`
+	for (int i in [1, 2, 3]) {
+		if (i == 1) {
+			continue;
+		}
+		if (i == 3) {
+			break;
+		}
+		printLn(i);
+	}
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// for i in 1 2 3
+	// do
+	// 	if [[ ${i} -eq 1 ]]
+	// 	then
+	// 		continue
+	// 	fi
+	// 	if [[ ${i} -eq 3 ]]
+	// 	then
+	// 		break
+	// 	fi
+	// 	echo "${i}"
+	// done
+}
+
+// -------- For lines -------- MARK: For lines
+
+func TestErrorLinesOutsideOfForLoop(t *testing.T) {
+	initTest()
+	err := transpileTest(`str[] lines = lines("file.txt");`)
+	expected := fmt.Errorf("test.scri:1:15: lines() can only be used in a for loop e.g. 'for (str line in lines(file))'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorForLinesWithIntIndex(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		for (int i in lines("file.txt")) {
+			printLn(i);
+		}
+	`)
+	expected := fmt.Errorf("test.scri:2:3: The variable for the lines of lines() must be of type 'StrLiteral'. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorForExecLinesWithIntArg(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		for (str line in execLines(42)) {
+			printLn(line);
+		}
+	`)
+	expected := fmt.Errorf("test.scri:2:20: execLines() - Parameter command must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_forLines() {
+	initTestForPrintMode()
+	transpileTest(`
+	str file = "/var/log/big.log";
+	for (str line in lines(file)) {
+		printLn(line);
+	}
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// file="/var/log/big.log"
+	// while IFS= read -r -u 3 line || [[ -n ${line} ]]
+	// do
+	// 	echo "${line}"
+	// done 3< "${file}"
+}
+
+func Example_forExecLines() {
+	initTestForPrintMode()
+	transpileTest(`
+	for (str line in execLines("journalctl -u nginx")) {
+		printLn(line);
+	}
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// while IFS= read -r -u 3 line || [[ -n ${line} ]]
+	// do
+	// 	echo "${line}"
+	// done 3< <(eval "journalctl -u nginx")
+}
+
+func TestForLinesKeepsWhitespaces(t *testing.T) {
+	output := runBashTest(t, `
+		for (str line in execLines("printf '  indented\\nlast line'")) {
+			printLn("[" + line + "]");
+		}
+	`)
+	expected := "[  indented]\n[last line]"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+func TestForLinesWithStdinInBody(t *testing.T) {
+	output := runBashTestWithStdin(t, `
+		for (str line in execLines("printf 'a\\nb\\nc'")) {
+			printLn(line + " " + input(""));
+		}
+	`, "1\n2\n3\n")
+	expected := "a 1\nb 2\nc 3"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Parallel for -------- MARK: Parallel for
+
+func TestErrorParallelForWithoutLimit(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		parallel for (int i in [1, 2]) limit {
+			printLn(i);
+		}
+	`)
+	expected := fmt.Errorf("test.scri:2:40: Expected limit of parallel for loop")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorParallelForWithStrLimit(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		parallel for (int i in [1, 2]) limit "2" {
+			printLn(i);
+		}
+	`)
+	expected := fmt.Errorf("test.scri:2:42: Limit of a parallel for loop must be an int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorParallelForWithZeroLimit(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		parallel for (int i in [1, 2]) limit 0 {
+			printLn(i);
+		}
+	`)
+	expected := fmt.Errorf("test.scri:2:40: Limit of a parallel for loop must be greater than 0")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorParallelForWithBreak(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		parallel for (int i in [1, 2]) {
+			break;
+		}
+	`)
+	expected := fmt.Errorf("test.scri:3:4: 'BreakExpr' is not allowed inside a parallel for loop")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorParallelForWithReturn(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func first(int[] values) int {
+			parallel for (int i in values) {
+				return i;
+			}
+			return 0;
+		}
+	`)
+	expected := fmt.Errorf("test.scri:4:5: Return is not allowed inside a parallel for loop")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorParallelForChangingCapturedVar(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		int sum = 0;
+		parallel for (int i in [1, 2]) {
+			sum = sum + i;
+		}
+	`)
+	expected := fmt.Errorf("test.scri:3:3: Parallel for loop must not change the captured variable 'sum' as each iteration runs in a background job")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorParallelForChangingCapturedVarInCalledFunc(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		int sum = 0;
+		func add(int value) void {
+			sum = sum + value;
+		}
+		parallel for (int i in [1, 2]) {
+			add(i);
+		}
+	`)
+	expected := fmt.Errorf("test.scri:6:3: Parallel for loop must not change the captured variable 'sum' as each iteration runs in a background job")
+	if err == nil || err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_parallelFor() {
+	initTestForPrintMode()
+	transpileTest(`
+	str[] hosts = ["a", "b", "c"];
+	parallel for (str host in hosts) limit 2 failFast {
+		exec("ping -c 1 " + host);
+	}
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # exec(str command) str
+	// exec () {
+	// 	local command=$1
+	// 	tmpStrs[${tmpIndex}]=$(eval ${command})
+	// 	execExitCode=$?
+	// }
+	//
+	// # parallelFor(int limit, bool failFast, func fn, array values) void
+	// parallelFor () {
+	// 	local limit=$1
+	// 	local failFast=$2
+	// 	local fn=$3
+	// 	local values=("${@:4}")
+	// 	local index
+	// 	local pid
+	// 	local code
+	// 	local running=0
+	// 	local failed=false
+	// 	local failedCode=0
+	// 	local pids=()
+	// 	local active=()
+	// 	for index in "${!values[@]}"
+	// 	do
+	// 		if [[ ${limit} -gt 0 && ${running} -ge ${limit} ]]
+	// 		then
+	// 			wait -n -p pid "${active[@]}"
+	// 			code=$?
+	// 			unset "active[${pid}]"
+	// 			running=$((running - 1))
+	// 			if [[ ${code} -ne 0 && ${failFast} == "true" ]]
+	// 			then
+	// 				failed=true
+	// 				failedCode=${code}
+	// 				break
+	// 			fi
+	// 		fi
+	// 		( "${fn}" "${values[${index}]}" ) &
+	// 		pids[${index}]=$!
+	// 		active[$!]=$!
+	// 		running=$((running + 1))
+	// 	done
+	// 	while [[ ${failFast} == "true" && ${failed} == false && ${running} -gt 0 ]]
+	// 	do
+	// 		wait -n -p pid "${active[@]}"
+	// 		code=$?
+	// 		unset "active[${pid}]"
+	// 		running=$((running - 1))
+	// 		if [[ ${code} -ne 0 ]]
+	// 		then
+	// 			failed=true
+	// 			failedCode=${code}
+	// 		fi
+	// 	done
+	// 	if [[ ${failed} == true ]]
+	// 	then
+	// 		for pid in "${active[@]}"
+	// 		do
+	// 			kill -TERM "${pid}" $(pgrep -P "${pid}") 2> /dev/null
+	// 		done
+	// 	fi
+	// 	parallelCodes=()
+	// 	execExitCode=0
+	// 	for index in "${!values[@]}"
+	// 	do
+	// 		code=-1
+	// 		if [[ -n "${pids[${index}]}" ]]
+	// 		then
+	// 			wait "${pids[${index}]}"
+	// 			code=$?
+	// 		fi
+	// 		parallelCodes[${index}]=${code}
+	// 		if [[ ${execExitCode} -eq 0 ]]
+	// 		then
+	// 			execExitCode=${code}
+	// 		fi
+	// 	done
+	// 	if [[ ${failed} == true ]]
+	// 	then
+	// 		execExitCode=${failedCode}
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// hosts=("a" "b" "c")
+	// # parallelForBody1(str host) void
+	// parallelForBody1 () {
+	// 	local host=$1
+	// 	tmpIndex=0
+	// 	exec "ping -c 1 ${host}"
+	// }
+	//
+	// parallelFor 2 "true" "parallelForBody1" "${hosts[@]}"
+}
+
+func TestParallelForLimit(t *testing.T) {
+	output := runBashTest(t, `
+		parallel for (int i in [1, 2]) limit 1 {
+			if (i == 1) {
+				sleep(1);
+			}
+			printLn(i);
+		}
+	`)
+	expected := "1\n2"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+func TestParallelForExitCodes(t *testing.T) {
+	output := runBashTest(t, `
+		parallel for (int i in [0, 3, 0]) {
+			exit(i);
+		}
+		int exitCode = lastExitCode();
+		printLn(exitCode, parallelExitCodes());
+	`)
+	expected := "3 0 3 0"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+func TestParallelForFailFast(t *testing.T) {
+	output := runBashTest(t, `
+		parallel for (int i in [2, 0, 0, 0]) limit 2 failFast {
+			if (i == 2) {
+				exit(i);
+			}
+			sleep(5);
+		}
+		int exitCode = lastExitCode();
+		printLn(exitCode, parallelExitCodes());
+	`)
+	expected := "2 2 143 -1 -1"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+func TestParallelForFailFastReportsFailedIteration(t *testing.T) {
+	output := runBashTest(t, `
+		parallel for (int i in [0, 4, 0]) limit 3 failFast {
+			if (i == 4) {
+				exit(i);
+			}
+			sleep(5);
+		}
+		int exitCode = lastExitCode();
+		printLn(exitCode, parallelExitCodes());
+	`)
+	expected := "4 143 4 143"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
 // -------- While -------- MARK: While
 
 func TestErrorWhileWithoutOpenParen(t *testing.T) {
@@ -175,7 +582,7 @@ func Example_while() {
 func TestErrorContinueOutsideOfWhile(t *testing.T) {
 	initTest()
 	err := transpileTest(`continue;`)
-	expected := fmt.Errorf("test.scri:1:1: 'ContinueExpr' is only allowed inside a while loop")
+	expected := fmt.Errorf("test.scri:1:1: 'ContinueExpr' is only allowed inside a for or while loop")
 	if err.Error() != expected.Error() {
 		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
 	}
@@ -184,7 +591,7 @@ func TestErrorContinueOutsideOfWhile(t *testing.T) {
 func TestErrorBreakOutsideOfWhile(t *testing.T) {
 	initTest()
 	err := transpileTest(`break;`)
-	expected := fmt.Errorf("test.scri:1:1: 'BreakExpr' is only allowed inside a while loop")
+	expected := fmt.Errorf("test.scri:1:1: 'BreakExpr' is only allowed inside a for or while loop")
 	if err.Error() != expected.Error() {
 		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
 	}
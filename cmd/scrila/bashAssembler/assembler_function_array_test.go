@@ -0,0 +1,843 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "ArrAll" -------- MARK: ArrAll
+
+func TestErrorArrAllWithoutFunction(t *testing.T) {
+	initTest()
+	err := transpileTest(`arrAll([1, 2], 1);`)
+	expected := fmt.Errorf("test.scri:1:1: arrAll() - Parameter fn must be a user defined function. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorArrAllWithWrongFunctionReturnType(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func toStr(int value) str {
+			return "a";
+		}
+		arrAll([1, 2], toStr);
+	`)
+	expected := fmt.Errorf("test.scri:5:3: arrAll() - Function 'toStr' must return 'BoolLiteral'. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_arrAll() {
+	initTestForPrintMode()
+	transpileTest(`
+		func isEven(int value) bool {
+			return value / 2 * 2 == value;
+		}
+		bool allEven = arrAll([2, 4], isEven);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrAll(array values, str fn) bool
+	// arrAll () {
+	// 	local values=("${@:1:$#-1}")
+	// 	local fn=${@: -1:1}
+	// 	local index=${tmpIndex}
+	// 	local value
+	// 	for value in "${values[@]}"
+	// 	do
+	// 		"${fn}" "${value}"
+	// 		if [[ "${tmpBools[${tmpIndex}]}" != "true" ]]
+	// 		then
+	// 			tmpIndex=${index}
+	// 			tmpBools[${tmpIndex}]="false"
+	// 			return
+	// 		fi
+	// 	done
+	// 	tmpIndex=${index}
+	// 	tmpBools[${tmpIndex}]="true"
+	// }
+	//
+	// # User script
+	//
+	// # isEven(int value) bool
+	// isEven () {
+	// 	local value=$1
+	// 	if [[ $(($((${value} / 2)) * 2)) -eq ${value} ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// 	return
+	// }
+	//
+	// tmpIndex=0
+	// arrAll 2 4 "isEven"
+	// allEven="${tmpBools[0]}"
+}
+
+// -------- Native function "ArrAny" -------- MARK: ArrAny
+
+func Example_arrAny() {
+	initTestForPrintMode()
+	transpileTest(`
+		func isEven(int value) bool {
+			return value / 2 * 2 == value;
+		}
+		bool anyEven = arrAny([1, 2], isEven);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrAny(array values, str fn) bool
+	// arrAny () {
+	// 	local values=("${@:1:$#-1}")
+	// 	local fn=${@: -1:1}
+	// 	local index=${tmpIndex}
+	// 	local value
+	// 	for value in "${values[@]}"
+	// 	do
+	// 		"${fn}" "${value}"
+	// 		if [[ "${tmpBools[${tmpIndex}]}" == "true" ]]
+	// 		then
+	// 			tmpIndex=${index}
+	// 			tmpBools[${tmpIndex}]="true"
+	// 			return
+	// 		fi
+	// 	done
+	// 	tmpIndex=${index}
+	// 	tmpBools[${tmpIndex}]="false"
+	// }
+	//
+	// # User script
+	//
+	// # isEven(int value) bool
+	// isEven () {
+	// 	local value=$1
+	// 	if [[ $(($((${value} / 2)) * 2)) -eq ${value} ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// 	return
+	// }
+	//
+	// tmpIndex=0
+	// arrAny 1 2 "isEven"
+	// anyEven="${tmpBools[0]}"
+}
+
+// -------- Native function "ArrContains" -------- MARK: ArrContains
+
+func TestErrorArrContainsWithoutValue(t *testing.T) {
+	initTest()
+	err := transpileTest(`arrContains([1, 2]);`)
+	expected := fmt.Errorf("test.scri:1:1: Expected syntax: arrContains(array values, value)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorArrContainsWithWrongArg0Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`arrContains(123, 1);`)
+	expected := fmt.Errorf("test.scri:1:1: arrContains() - Parameter values must be a typed array or a variable of type array. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorArrContainsWithWrongArg1Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		int[] ints = [1, 2];
+		arrContains(ints, "1");
+	`)
+	expected := fmt.Errorf("test.scri:3:3: arrContains() - Parameter value must be of the array data type 'IntLiteral'. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_arrContains() {
+	initTestForPrintMode()
+	transpileTest(`
+		int[] ints = [1, 2];
+		bool contains = arrContains(ints, 2);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrContains(array values, str value) bool
+	// arrContains () {
+	// 	local values=("${@:1:$#-1}")
+	// 	local value=${@: -1:1}
+	// 	tmpBools[${tmpIndex}]="false"
+	// 	local element
+	// 	for element in "${values[@]}"
+	// 	do
+	// 		if [[ "${element}" == "${value}" ]]
+	// 		then
+	// 			tmpBools[${tmpIndex}]="true"
+	// 			break
+	// 		fi
+	// 	done
+	// }
+	//
+	// # User script
+	//
+	// ints=(1 2)
+	// tmpIndex=0
+	// arrContains "${ints[@]}" 2
+	// contains="${tmpBools[0]}"
+}
+
+// -------- Native function "ArrFilter" -------- MARK: ArrFilter
+
+func TestErrorArrFilterWithWrongFunctionParamType(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func isEven(int value) bool {
+			return value / 2 * 2 == value;
+		}
+		arrFilter(["a"], isEven);
+	`)
+	expected := fmt.Errorf("test.scri:5:3: arrFilter() - Parameter 'value' of function 'isEven' must be of type 'StrLiteral'. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorArrFilterWithWrongFunctionParamCount(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func isBigger(int a, int b) bool {
+			return a > b;
+		}
+		arrFilter([1], isBigger);
+	`)
+	expected := fmt.Errorf("test.scri:5:3: arrFilter() - Function 'isBigger' must have 1 parameter(s). Got 2")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_arrFilter() {
+	initTestForPrintMode()
+	transpileTest(`
+		func isEven(int value) bool {
+			return value / 2 * 2 == value;
+		}
+		int[] evens = arrFilter([1, 2, 3, 4], isEven);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrFilter(str resultVar, array values, str fn) array
+	// arrFilter () {
+	// 	local resultVar=$1
+	// 	local values=("${@:2:$#-2}")
+	// 	local fn=${@: -1:1}
+	// 	local -n result=${resultVar}
+	// 	local filtered=()
+	// 	local index=${tmpIndex}
+	// 	local value
+	// 	for value in "${values[@]}"
+	// 	do
+	// 		"${fn}" "${value}"
+	// 		if [[ "${tmpBools[${tmpIndex}]}" == "true" ]]
+	// 		then
+	// 			filtered+=("${value}")
+	// 		fi
+	// 	done
+	// 	tmpIndex=${index}
+	// 	result=("${filtered[@]}")
+	// }
+	//
+	// # User script
+	//
+	// # isEven(int value) bool
+	// isEven () {
+	// 	local value=$1
+	// 	if [[ $(($((${value} / 2)) * 2)) -eq ${value} ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// 	return
+	// }
+	//
+	// tmpIndex=0
+	// arrFilter "tmpInts" 1 2 3 4 "isEven"
+	// evens=("${tmpInts[@]}")
+}
+
+// -------- Native function "ArrIndexOf" -------- MARK: ArrIndexOf
+
+func TestErrorArrIndexOfWithWrongArg1Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`arrIndexOf(["a", "b"], true);`)
+	expected := fmt.Errorf("test.scri:1:1: arrIndexOf() - Parameter value must be of the array data type 'StrLiteral'. Got 'BoolLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_arrIndexOf() {
+	initTestForPrintMode()
+	transpileTest(`
+		int index = arrIndexOf(["a", "b"], "b");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrIndexOf(array values, str value) int
+	// arrIndexOf () {
+	// 	local values=("${@:1:$#-1}")
+	// 	local value=${@: -1:1}
+	// 	tmpInts[${tmpIndex}]=-1
+	// 	local i
+	// 	for i in "${!values[@]}"
+	// 	do
+	// 		if [[ "${values[i]}" == "${value}" ]]
+	// 		then
+	// 			tmpInts[${tmpIndex}]=${i}
+	// 			break
+	// 		fi
+	// 	done
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// arrIndexOf "a" "b" "b"
+	// index=${tmpInts[0]}
+}
+
+// -------- Native function "ArrInsert" -------- MARK: ArrInsert
+
+func TestErrorArrInsertWithWrongIndexType(t *testing.T) {
+	initTest()
+	err := transpileTest(`arrInsert([1, 2], "0", 3);`)
+	expected := fmt.Errorf("test.scri:1:1: arrInsert() - Parameter index must be an int or a variable of type int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_arrInsert() {
+	initTestForPrintMode()
+	transpileTest(`
+		str[] strs = ["a", "c"];
+		strs = arrInsert(strs, 1, "b");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrInsert(str resultVar, array values, int index, str value) array
+	// arrInsert () {
+	// 	local resultVar=$1
+	// 	local values=("${@:2:$#-3}")
+	// 	local index=${@: -2:1}
+	// 	local value=${@: -1:1}
+	// 	local -n result=${resultVar}
+	// 	result=("${values[@]:0:${index}}" "${value}" "${values[@]:${index}}")
+	// }
+	//
+	// # User script
+	//
+	// strs=("a" "c")
+	// tmpIndex=0
+	// arrInsert "tmpStrs" "${strs[@]}" 1 "b"
+	// strs=("${tmpStrs[@]}")
+}
+
+// -------- Native function "ArrMap" -------- MARK: ArrMap
+
+func TestErrorArrMapWithVariableAsFunction(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		int double = 2;
+		arrMap([1, 2], double);
+	`)
+	expected := fmt.Errorf("test.scri:3:3: arrMap() - Parameter fn must be a user defined function. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorArrMapAssignWrongArrayType(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func double(int value) int {
+			return value * 2;
+		}
+		str[] strs = arrMap([1, 2], double);
+	`)
+	expected := fmt.Errorf("test.scri:5:16: Cannot assign a value of type 'IntArray' to a var of type 'StrArray'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_arrMap() {
+	initTestForPrintMode()
+	transpileTest(`
+		func isEven(int value) bool {
+			return value / 2 * 2 == value;
+		}
+		bool[] evens = arrMap([1, 2], isEven);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrMap(str resultVar, array values, str fn) array
+	// arrMap () {
+	// 	local resultVar=$1
+	// 	local values=("${@:2:$#-2}")
+	// 	local fn=${@: -1:1}
+	// 	local -n result=${resultVar}
+	// 	local mapped=()
+	// 	local index=${tmpIndex}
+	// 	local value
+	// 	for value in "${values[@]}"
+	// 	do
+	// 		"${fn}" "${value}"
+	// 		mapped+=("${result[${tmpIndex}]}")
+	// 	done
+	// 	tmpIndex=${index}
+	// 	result=("${mapped[@]}")
+	// }
+	//
+	// # User script
+	//
+	// # isEven(int value) bool
+	// isEven () {
+	// 	local value=$1
+	// 	if [[ $(($((${value} / 2)) * 2)) -eq ${value} ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// 	return
+	// }
+	//
+	// tmpIndex=0
+	// arrMap "tmpBools" 1 2 "isEven"
+	// evens=("${tmpBools[@]}")
+}
+
+// -------- Native function "ArrPop" -------- MARK: ArrPop
+
+func TestErrorArrPopWithoutValue(t *testing.T) {
+	initTest()
+	err := transpileTest(`arrPop();`)
+	expected := fmt.Errorf("test.scri:1:1: Expected syntax: arrPop(array values)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_arrPop() {
+	initTestForPrintMode()
+	transpileTest(`
+		int[] ints = [1, 2, 3];
+		ints = arrPop(ints);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrPop(str resultVar, array values) array
+	// arrPop () {
+	// 	local resultVar=$1
+	// 	local values=("${@:2}")
+	// 	local -n result=${resultVar}
+	// 	if [[ ${#values[@]} -gt 0 ]]
+	// 	then
+	// 		unset 'values[-1]'
+	// 	fi
+	// 	result=("${values[@]}")
+	// }
+	//
+	// # User script
+	//
+	// ints=(1 2 3)
+	// tmpIndex=0
+	// arrPop "tmpInts" "${ints[@]}"
+	// ints=("${tmpInts[@]}")
+}
+
+// -------- Native function "ArrPush" -------- MARK: ArrPush
+
+func TestErrorArrPushWithWrongArg1Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		bool[] bools = [true];
+		arrPush(bools, 1);
+	`)
+	expected := fmt.Errorf("test.scri:3:3: arrPush() - Parameter value must be of the array data type 'BoolLiteral'. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorArrPushAssignWrongArrayType(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		int[] ints = [1];
+		str[] strs = arrPush(ints, 2);
+	`)
+	expected := fmt.Errorf("test.scri:3:16: Cannot assign a value of type 'IntArray' to a var of type 'StrArray'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_arrPush() {
+	initTestForPrintMode()
+	transpileTest(`
+		int[] ints = [1, 2];
+		ints = arrPush(ints, 3);
+		# Nested generic calls
+		str[] strs = arrPush(arrPush(["a"], "b"), "c");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrPush(str resultVar, array values, str value) array
+	// arrPush () {
+	// 	local resultVar=$1
+	// 	local values=("${@:2:$#-2}")
+	// 	local value=${@: -1:1}
+	// 	local -n result=${resultVar}
+	// 	result=("${values[@]}" "${value}")
+	// }
+	//
+	// # User script
+	//
+	// ints=(1 2)
+	// tmpIndex=0
+	// arrPush "tmpInts" "${ints[@]}" 3
+	// ints=("${tmpInts[@]}")
+	// # Nested generic calls
+	// arrPush "tmpStrs" "a" "b"
+	// arrPush "tmpStrs" "${tmpStrs[@]}" "c"
+	// strs=("${tmpStrs[@]}")
+}
+
+// -------- Native function "ArrReduce" -------- MARK: ArrReduce
+
+func TestErrorArrReduceWithWrongFunctionReturnType(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func sum(int acc, int value) bool {
+			return true;
+		}
+		arrReduce([1, 2], 0, sum);
+	`)
+	expected := fmt.Errorf("test.scri:5:3: arrReduce() - Function 'sum' must return 'IntLiteral'. Got 'BoolLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_arrReduce() {
+	initTestForPrintMode()
+	transpileTest(`
+		func sum(int acc, int value) int {
+			return acc + value;
+		}
+		int total = arrReduce([1, 2, 3], 0, sum);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrReduce(str resultVar, array values, str init, str fn) str
+	// arrReduce () {
+	// 	local resultVar=$1
+	// 	local values=("${@:2:$#-3}")
+	// 	local init=${@: -2:1}
+	// 	local fn=${@: -1:1}
+	// 	local -n result=${resultVar}
+	// 	local acc="${init}"
+	// 	local index=${tmpIndex}
+	// 	local value
+	// 	for value in "${values[@]}"
+	// 	do
+	// 		"${fn}" "${acc}" "${value}"
+	// 		acc="${result[${tmpIndex}]}"
+	// 	done
+	// 	tmpIndex=${index}
+	// 	result[${tmpIndex}]="${acc}"
+	// }
+	//
+	// # User script
+	//
+	// # sum(int acc, int value) int
+	// sum () {
+	// 	local acc=$1
+	// 	local value=$2
+	// 	tmpInts[${tmpIndex}]=$((${acc} + ${value}))
+	// 	return
+	// }
+	//
+	// tmpIndex=0
+	// arrReduce "tmpInts" 1 2 3 0 "sum"
+	// total=${tmpInts[0]}
+}
+
+// -------- Native function "ArrRemoveAt" -------- MARK: ArrRemoveAt
+
+func Example_arrRemoveAt() {
+	initTestForPrintMode()
+	transpileTest(`
+		bool[] bools = [true, false, true];
+		bools = arrRemoveAt(bools, 1);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrRemoveAt(str resultVar, array values, int index) array
+	// arrRemoveAt () {
+	// 	local resultVar=$1
+	// 	local values=("${@:2:$#-2}")
+	// 	local index=${@: -1:1}
+	// 	local -n result=${resultVar}
+	// 	result=("${values[@]:0:${index}}" "${values[@]:$((${index} + 1))}")
+	// }
+	//
+	// # User script
+	//
+	// bools=("true" "false" "true")
+	// tmpIndex=0
+	// arrRemoveAt "tmpBools" "${bools[@]}" 1
+	// bools=("${tmpBools[@]}")
+}
+
+// -------- Native function "ArrReverse" -------- MARK: ArrReverse
+
+func Example_arrReverse() {
+	initTestForPrintMode()
+	transpileTest(`
+		int[] ints = arrReverse([1, 2, 3]);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrReverse(str resultVar, array values) array
+	// arrReverse () {
+	// 	local resultVar=$1
+	// 	local values=("${@:2}")
+	// 	local -n result=${resultVar}
+	// 	result=()
+	// 	local i
+	// 	for (( i=${#values[@]}-1; i>=0; i-- ))
+	// 	do
+	// 		result+=("${values[i]}")
+	// 	done
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// arrReverse "tmpInts" 1 2 3
+	// ints=("${tmpInts[@]}")
+}
+
+// -------- Native function "ArrSort" -------- MARK: ArrSort
+
+func TestErrorArrSortWithWrongArgType(t *testing.T) {
+	initTest()
+	err := transpileTest(`arrSort("a,b");`)
+	expected := fmt.Errorf("test.scri:1:1: arrSort() - Parameter values must be a typed array or a variable of type array. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_arrSort() {
+	initTestForPrintMode()
+	transpileTest(`
+		int[] ints = arrSort([10, 9, 100]);
+		str[] strs = arrSort(["b", "a"]);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrSort(str resultVar, array values) array
+	// arrSort () {
+	// 	local resultVar=$1
+	// 	local values=("${@:2}")
+	// 	local -n result=${resultVar}
+	// 	result=()
+	// 	if [[ ${#values[@]} -eq 0 ]]
+	// 	then
+	// 		return
+	// 	fi
+	// 	if [[ "${resultVar}" == "tmpInts" ]]
+	// 	then
+	// 		mapfile -t result < <(printf '%s\n' "${values[@]}" | sort -n)
+	// 	elif [[ "${resultVar}" == "tmpFloats" ]]
+	// 	then
+	// 		mapfile -t result < <(printf '%s\n' "${values[@]}" | LC_ALL=C sort -g)
+	// 	else
+	// 		mapfile -t result < <(printf '%s\n' "${values[@]}" | LC_ALL=C sort)
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// arrSort "tmpInts" 10 9 100
+	// ints=("${tmpInts[@]}")
+	// arrSort "tmpStrs" "b" "a"
+	// strs=("${tmpStrs[@]}")
+}
+
+func TestArrSortWithFloats(t *testing.T) {
+	output := runBashTest(t, `
+		float[] floats = arrSort([10.5, 2.0, 9.25]);
+		printLn(floats);
+	`)
+	expected := "2.0 9.25 10.5"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "ArrUnique" -------- MARK: ArrUnique
+
+func Example_arrUnique() {
+	initTestForPrintMode()
+	transpileTest(`
+		str[] strs = arrUnique(["a", "b", "a"]);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # arrUnique(str resultVar, array values) array
+	// arrUnique () {
+	// 	local resultVar=$1
+	// 	local values=("${@:2}")
+	// 	local -n result=${resultVar}
+	// 	result=()
+	// 	local -A seen=()
+	// 	local value
+	// 	for value in "${values[@]}"
+	// 	do
+	// 		if [[ -z "${seen["_${value}"]}" ]]
+	// 		then
+	// 			seen["_${value}"]=1
+	// 			result+=("${value}")
+	// 		fi
+	// 	done
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// arrUnique "tmpStrs" "a" "b" "a"
+	// strs=("${tmpStrs[@]}")
+}
+
+// -------- Native function "StrJoin" -------- MARK: StrJoin
+
+func TestErrorStrJoinWithWrongArg0Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`strJoin([1, 2], ",");`)
+	expected := fmt.Errorf("test.scri:1:1: strJoin() - Parameter parts must be a string array or a variable of type string array. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorStrJoinWithWrongArg1Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`strJoin(["a", "b"], 1);`)
+	expected := fmt.Errorf("test.scri:1:1: strJoin() - Parameter separator must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_strJoin() {
+	initTestForPrintMode()
+	transpileTest(`
+		str[] strs = ["a", "b"];
+		str joined = strJoin(strs, ", ");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strJoin(str[] parts, str separator) str
+	// strJoin () {
+	// 	local parts=("${@:1:$#-1}")
+	// 	local separator=${@: -1:1}
+	// 	local joined=""
+	// 	local part
+	// 	for part in "${parts[@]}"
+	// 	do
+	// 		joined+="${separator}${part}"
+	// 	done
+	// 	tmpStrs[${tmpIndex}]="${joined:${#separator}}"
+	// }
+	//
+	// # User script
+	//
+	// strs=("a" "b")
+	// tmpIndex=0
+	// strJoin "${strs[@]}" ", "
+	// joined="${tmpStrs[0]}"
+}
@@ -0,0 +1,145 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "glob" -------- MARK: glob
+
+func TestErrorGlobWithInt(t *testing.T) {
+	initTest()
+	err := transpileTest(`str[] files = glob(42);`)
+	expected := fmt.Errorf("test.scri:1:15: glob() - Parameter pattern must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_glob() {
+	initTestForPrintMode()
+	transpileTest(`for (str file in glob("*.log")) {
+			printLn(file);
+		}`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # glob(str pattern) str[]
+	// glob () {
+	// 	local pattern=$1
+	// 	local restoreNullglob
+	// 	restoreNullglob=$(shopt -p nullglob)
+	// 	shopt -s nullglob
+	// 	# The pattern is not quoted to be expanded. An empty IFS prevents word splitting.
+	// 	local IFS=""
+	// 	tmpStrs=(${pattern})
+	// 	eval "${restoreNullglob}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// glob "*.log"
+	// glob "*.log"
+	// for file in "${tmpStrs[@]}"
+	// do
+	// 	echo "${file}"
+	// done
+}
+
+func TestGlobWithoutMatch(t *testing.T) {
+	output := runBashTest(t, `
+		int count = 0;
+		for (str file in glob("/nonexistent/*.log")) {
+			count = count + 1;
+		}
+		printLn(count);
+	`)
+	if output != "0" {
+		t.Errorf("Expected: \"0\", Got: \"%s\"", output)
+	}
+}
+
+// -------- Native function "listDir" -------- MARK: listDir
+
+func TestErrorListDirAssignToStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = listDir("/tmp");`)
+	expected := fmt.Errorf("test.scri:1:9: Cannot assign a value of type 'StrArray' to a var of type 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_listDir() {
+	initTestForPrintMode()
+	transpileTest(`str[] entries = listDir("/tmp");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # listDir(str path) str[]
+	// listDir () {
+	// 	local path=$1
+	// 	[[ -d "${path}" ]] || nativeError "listDir() - Directory '${path}' does not exist"
+	// 	local restoreNullglob restoreDotglob
+	// 	restoreNullglob=$(shopt -p nullglob)
+	// 	restoreDotglob=$(shopt -p dotglob)
+	// 	shopt -s nullglob dotglob
+	// 	local entries=("${path%/}"/*)
+	// 	eval "${restoreNullglob}"
+	// 	eval "${restoreDotglob}"
+	// 	tmpStrs=("${entries[@]##*/}")
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// listDir "/tmp"
+	// entries=("${tmpStrs[@]}")
+}
+
+// -------- Native function "walk" -------- MARK: walk
+
+func Example_walk() {
+	initTestForPrintMode()
+	transpileTest(`str[] files = walk("/tmp");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # walk(str root) str[]
+	// walk () {
+	// 	local root=$1
+	// 	[[ -d "${root}" ]] || nativeError "walk() - Directory '${root}' does not exist"
+	// 	# Null-terminated file names are safe for names with spaces and newlines
+	// 	mapfile -t -d '' tmpStrs < <(find "${root}" -type f -print0 | sort -z)
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// walk "/tmp"
+	// files=("${tmpStrs[@]}")
+}
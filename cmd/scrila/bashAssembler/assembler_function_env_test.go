@@ -0,0 +1,324 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "envGet" -------- MARK: envGet
+
+func TestErrorEnvGetWithoutName(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = envGet();`)
+	expected := fmt.Errorf("test.scri:1:9: Expected syntax: envGet(str name)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorEnvGetWithInvalidName(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = envGet("MY-VAR");`)
+	expected := fmt.Errorf("test.scri:1:9: envGet() - Invalid environment variable name 'MY-VAR'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_envGet() {
+	initTestForPrintMode()
+	transpileTest(`str home = envGet("HOME");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # envGet(str envVarName) str
+	// envGet () {
+	// 	local envVarName=$1
+	// 	if [[ ! ${envVarName} =~ ^[a-zA-Z_][a-zA-Z0-9_]*$ ]]
+	// 	then
+	// 		nativeError "envGet() - Invalid environment variable name '${envVarName}'"
+	// 	fi
+	// 	if [[ ${!envVarName@a} == *x* ]]
+	// 	then
+	// 		tmpStrs[${tmpIndex}]="${!envVarName}"
+	// 	else
+	// 		tmpStrs[${tmpIndex}]=""
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// envGet "HOME"
+	// home="${tmpStrs[0]}"
+}
+
+func TestEnvGetIgnoresScrilaVars(t *testing.T) {
+	output := runBashTest(t, `
+		# The name is given as variable as a literal name of a ScriLa variable is rejected when transpiling
+		str notExported = "scrila";
+		str name = "notExported";
+		printLn("[" + envGet(name) + "]", envHas(name), envGet("HOME") != "");
+	`)
+	expected := "[] false true"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+func TestErrorDeclareVarWithEnvVarName(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		str home = envGet("HOME");
+		str HOME = "mine";`)
+	expected := fmt.Errorf("test.scri:3:7: Cannot declare variable 'HOME' as the name is used for an environment variable")
+	if err == nil || err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFuncParamWithEnvVarName(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		envSet("TARGET", "prod");
+		func deploy(str TARGET) void {
+			printLn(TARGET);
+		}
+	`)
+	expected := fmt.Errorf("test.scri:3:3: Cannot declare variable 'TARGET' as the name is used for an environment variable")
+	if err == nil || err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorEnvGetWithScrilaVarName(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func setup() void {
+			str PATH = "/opt/bin";
+		}
+		str path = envGet("PATH");`)
+	expected := fmt.Errorf("test.scri:5:14: envGet() - The environment variable 'PATH' has the same name as a variable of the script")
+	if err == nil || err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestUpperCaseVarNames(t *testing.T) {
+	output := runBashTest(t, `
+		const int MAX = 3;
+		int A = 1;
+		printLn(A + MAX, envHas("HOME"));
+	`)
+	expected := "4 true"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "envGetOr" -------- MARK: envGetOr
+
+func TestErrorEnvGetOrWithWrongDefaultType(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = envGetOr("PORT", 8080);`)
+	expected := fmt.Errorf("test.scri:1:9: envGetOr() - Parameter default must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_envGetOr() {
+	initTestForPrintMode()
+	transpileTest(`str port = envGetOr("PORT", "8080");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # envGetOr(str envVarName, str envVarDefault) str
+	// envGetOr () {
+	// 	local envVarName=$1
+	// 	local envVarDefault=$2
+	// 	if [[ ! ${envVarName} =~ ^[a-zA-Z_][a-zA-Z0-9_]*$ ]]
+	// 	then
+	// 		nativeError "envGetOr() - Invalid environment variable name '${envVarName}'"
+	// 	fi
+	// 	if [[ -v ${envVarName} && ${!envVarName@a} == *x* ]]
+	// 	then
+	// 		tmpStrs[${tmpIndex}]="${!envVarName}"
+	// 	else
+	// 		tmpStrs[${tmpIndex}]="${envVarDefault}"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// envGetOr "PORT" "8080"
+	// port="${tmpStrs[0]}"
+}
+
+// -------- Native function "envHas" -------- MARK: envHas
+
+func Example_envHas() {
+	initTestForPrintMode()
+	transpileTest(`
+	if (envHas("CI")) {
+		printLn("Running in CI");
+	}
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # envHas(str envVarName) bool
+	// envHas () {
+	// 	local envVarName=$1
+	// 	if [[ ! ${envVarName} =~ ^[a-zA-Z_][a-zA-Z0-9_]*$ ]]
+	// 	then
+	// 		nativeError "envHas() - Invalid environment variable name '${envVarName}'"
+	// 	fi
+	// 	if [[ -v ${envVarName} && ${!envVarName@a} == *x* ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// envHas "CI"
+	// if [[ "${tmpBools[0]}" == "true" ]]
+	// then
+	// 	echo "Running in CI"
+	// fi
+}
+
+// -------- Native function "envSet" -------- MARK: envSet
+
+func TestErrorEnvSetWithInvalidName(t *testing.T) {
+	initTest()
+	err := transpileTest(`envSet("1ST", "value");`)
+	expected := fmt.Errorf("test.scri:1:1: envSet() - Invalid environment variable name '1ST'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_envSet() {
+	initTestForPrintMode()
+	transpileTest(`envSet("LANG", "C");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # envSet(str envVarName, str envVarValue) void
+	// envSet () {
+	// 	local envVarName=$1
+	// 	local envVarValue=$2
+	// 	if [[ ! ${envVarName} =~ ^[a-zA-Z_][a-zA-Z0-9_]*$ ]]
+	// 	then
+	// 		nativeError "envSet() - Invalid environment variable name '${envVarName}'"
+	// 	fi
+	// 	export "${envVarName}=${envVarValue}"
+	// }
+	//
+	// # User script
+	//
+	// envSet "LANG" "C"
+}
+
+func TestEnvSetAndUnset(t *testing.T) {
+	output := runBashTest(t, `
+		envSet("SCRILA_TEST", "a  b");
+		printLn(exec("printenv SCRILA_TEST"), envGetOr("SCRILA_TEST", "unset"));
+		envUnset("SCRILA_TEST");
+		printLn(envHas("SCRILA_TEST"), envGetOr("SCRILA_TEST", "unset"));
+	`)
+	expected := "a  b a  b\nfalse unset"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+func TestEnvGetWithInvalidNameAtRuntime(t *testing.T) {
+	output := runFailingBashTest(t, `
+		str name = "a[0]";
+		envGet(name);
+	`)
+	expected := "ScriLa error: envGet() - Invalid environment variable name 'a[0]'"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "envUnset" -------- MARK: envUnset
+
+func Example_envUnset() {
+	initTestForPrintMode()
+	transpileTest(`envUnset("http_proxy");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # envUnset(str envVarName) void
+	// envUnset () {
+	// 	local envVarName=$1
+	// 	if [[ ! ${envVarName} =~ ^[a-zA-Z_][a-zA-Z0-9_]*$ ]]
+	// 	then
+	// 		nativeError "envUnset() - Invalid environment variable name '${envVarName}'"
+	// 	fi
+	// 	unset -v "${envVarName}"
+	// }
+	//
+	// # User script
+	//
+	// envUnset "http_proxy"
+}
@@ -0,0 +1,457 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "appendFile" -------- MARK: appendFile
+
+func TestErrorAppendFileWithMissingArg(t *testing.T) {
+	initTest()
+	err := transpileTest(`appendFile("out.txt");`)
+	expected := fmt.Errorf("test.scri:1:1: Expected syntax: appendFile(str path, str content)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_appendFile() {
+	initTestForPrintMode()
+	transpileTest(`appendFile("out.txt", "Hello World");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # appendFile(str path, str content) void
+	// appendFile () {
+	// 	local path=$1
+	// 	local content=$2
+	// 	printf '%s' "${content}" >> "${path}" || nativeError "appendFile() - Cannot append to file '${path}'"
+	// }
+	//
+	// # User script
+	//
+	// appendFile "out.txt" "Hello World"
+}
+
+// -------- Native function "copy" -------- MARK: copy
+
+func Example_copy() {
+	initTestForPrintMode()
+	transpileTest(`copy("a.txt", "b.txt");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # scrila_copy(str source, str destination) void
+	// scrila_copy () {
+	// 	local source=$1
+	// 	local destination=$2
+	// 	cp -R -- "${source}" "${destination}" || nativeError "copy() - Cannot copy '${source}' to '${destination}'"
+	// }
+	//
+	// # User script
+	//
+	// scrila_copy "a.txt" "b.txt"
+}
+
+// -------- Native function "dirExists" -------- MARK: dirExists
+
+func Example_dirExists() {
+	initTestForPrintMode()
+	transpileTest(`bool b = dirExists("/tmp");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # dirExists(str path) bool
+	// dirExists () {
+	// 	local path=$1
+	// 	if [[ -d "${path}" ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// dirExists "/tmp"
+	// b="${tmpBools[0]}"
+}
+
+// -------- Native function "fileExists" -------- MARK: fileExists
+
+func TestErrorFileExistsWithInt(t *testing.T) {
+	initTest()
+	err := transpileTest(`bool b = fileExists(42);`)
+	expected := fmt.Errorf("test.scri:1:10: fileExists() - Parameter path must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_fileExists() {
+	initTestForPrintMode()
+	transpileTest(`bool b = fileExists("a.txt");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # fileExists(str path) bool
+	// fileExists () {
+	// 	local path=$1
+	// 	if [[ -e "${path}" ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// fileExists "a.txt"
+	// b="${tmpBools[0]}"
+}
+
+// -------- Native function "fileSize" -------- MARK: fileSize
+
+func Example_fileSize() {
+	initTestForPrintMode()
+	transpileTest(`int i = fileSize("a.txt");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # fileSize(str path) int
+	// fileSize () {
+	// 	local path=$1
+	// 	local size
+	// 	size=$(wc -c < "${path}") || nativeError "fileSize() - Cannot read file '${path}'"
+	// 	tmpInts[${tmpIndex}]=$((size))
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// fileSize "a.txt"
+	// i=${tmpInts[0]}
+}
+
+// -------- Native function "isDir" -------- MARK: isDir
+
+func Example_isDir() {
+	initTestForPrintMode()
+	transpileTest(`bool b = isDir("/tmp");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # isDir(str path) bool
+	// isDir () {
+	// 	local path=$1
+	// 	if [[ -d "${path}" && ! -L "${path}" ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// isDir "/tmp"
+	// b="${tmpBools[0]}"
+}
+
+// -------- Native function "isFile" -------- MARK: isFile
+
+func Example_isFile() {
+	initTestForPrintMode()
+	transpileTest(`bool b = isFile("a.txt");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # isFile(str path) bool
+	// isFile () {
+	// 	local path=$1
+	// 	if [[ -f "${path}" && ! -L "${path}" ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// isFile "a.txt"
+	// b="${tmpBools[0]}"
+}
+
+// -------- Native function "mkdir" -------- MARK: mkdir
+
+func TestErrorMkdirWithStrParents(t *testing.T) {
+	initTest()
+	err := transpileTest(`mkdir("a/b", "true");`)
+	expected := fmt.Errorf("test.scri:1:1: mkdir() - Parameter parents must be a bool or a variable of type bool. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_mkdir() {
+	initTestForPrintMode()
+	transpileTest(`mkdir("a/b", true);`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # scrila_mkdir(str path, bool parents) void
+	// scrila_mkdir () {
+	// 	local path=$1
+	// 	local parents=$2
+	// 	if [[ "${parents}" == "true" ]]
+	// 	then
+	// 		command mkdir -p -- "${path}" || nativeError "mkdir() - Cannot create directory '${path}'"
+	// 	else
+	// 		command mkdir -- "${path}" || nativeError "mkdir() - Cannot create directory '${path}'"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// scrila_mkdir "a/b" "true"
+}
+
+func TestMkdirDoesNotShadowCommand(t *testing.T) {
+	dir := t.TempDir()
+	output := runBashTest(t, `
+		mkdir("`+dir+`/a", false);
+		exec("cd `+dir+` && mkdir -p b/c");
+		printLn(dirExists("`+dir+`/b/c"), dirExists("`+dir+`/-p"));
+	`)
+	expected := "true false"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "move" -------- MARK: move
+
+func Example_move() {
+	initTestForPrintMode()
+	transpileTest(`move("a.txt", "b.txt");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # scrila_move(str source, str destination) void
+	// scrila_move () {
+	// 	local source=$1
+	// 	local destination=$2
+	// 	mv -- "${source}" "${destination}" || nativeError "move() - Cannot move '${source}' to '${destination}'"
+	// }
+	//
+	// # User script
+	//
+	// scrila_move "a.txt" "b.txt"
+}
+
+// -------- Native function "readFile" -------- MARK: readFile
+
+func Example_readFile() {
+	initTestForPrintMode()
+	transpileTest(`str s = readFile("a.txt");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # readFile(str path) str
+	// readFile () {
+	// 	local path=$1
+	// 	tmpStrs[${tmpIndex}]=$(< "${path}") || nativeError "readFile() - Cannot read file '${path}'"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// readFile "a.txt"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "readLines" -------- MARK: readLines
+
+func TestErrorReadLinesAssignToStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = readLines("a.txt");`)
+	expected := fmt.Errorf("test.scri:1:9: Cannot assign a value of type 'StrArray' to a var of type 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_readLines() {
+	initTestForPrintMode()
+	transpileTest(`str[] lines = readLines("a.txt");
+		for (str line in lines) {
+			printLn(line);
+		}`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # readLines(str path) str[]
+	// readLines () {
+	// 	local path=$1
+	// 	mapfile -t tmpStrs < "${path}" || nativeError "readLines() - Cannot read file '${path}'"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// readLines "a.txt"
+	// lines=("${tmpStrs[@]}")
+	// for line in "${lines[@]}"
+	// do
+	// 	echo "${line}"
+	// done
+}
+
+// -------- Native function "remove" -------- MARK: remove
+
+func Example_remove() {
+	initTestForPrintMode()
+	transpileTest(`remove("a.txt");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # scrila_remove(str path) void
+	// scrila_remove () {
+	// 	local path=$1
+	// 	rm -R -- "${path}" || nativeError "remove() - Cannot remove '${path}'"
+	// }
+	//
+	// # User script
+	//
+	// scrila_remove "a.txt"
+}
+
+// -------- Native function "writeFile" -------- MARK: writeFile
+
+func Example_writeFile() {
+	initTestForPrintMode()
+	transpileTest(`writeFile("out.txt", "Hello World");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # writeFile(str path, str content) void
+	// writeFile () {
+	// 	local path=$1
+	// 	local content=$2
+	// 	printf '%s' "${content}" > "${path}" || nativeError "writeFile() - Cannot write file '${path}'"
+	// }
+	//
+	// # User script
+	//
+	// writeFile "out.txt" "Hello World"
+}
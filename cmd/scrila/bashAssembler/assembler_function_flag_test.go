@@ -0,0 +1,592 @@
+package bashAssembler
+
+import (
+	"ScriLa/cmd/scrila/config"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// -------- Native function "flagBool" -------- MARK: flagBool
+
+func TestErrorFlagBoolWithDefault(t *testing.T) {
+	initTest()
+	err := transpileTest(`bool b = flagBool("verbose", "v", false, "Print more output");`)
+	expected := fmt.Errorf("test.scri:1:10: Expected syntax: flagBool(str name, str short, str description)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_flagBool() {
+	initTestForPrintMode()
+	transpileTest(`bool verbose = flagBool("verbose", "v", "Print more output");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # flagBool(str name, str short, str description) bool
+	// flagBool () {
+	// 	local name=$1
+	// 	local short=$2
+	// 	local description=$3
+	// 	tmpBools[${tmpIndex}]="${flagValues[${name}]}"
+	// }
+	//
+	// # flagHelp() void
+	// flagHelp () {
+	// 	echo "Usage: ${0##*/} [options] [arguments]"
+	// 	echo ""
+	// 	echo "Options:"
+	// 	echo '  -v, --verbose  Print more output'
+	// 	echo '  -h, --help     Show this help'
+	// }
+	//
+	// # flagUsageError(str message) void
+	// flagUsageError () {
+	// 	local message=$1
+	// 	echo "${0##*/}: ${message}" >&2
+	// 	echo "Try '${0##*/} --help' for more information." >&2
+	// 	exit 2
+	// }
+	//
+	// # parseFlags(str[] args) void
+	// parseFlags () {
+	// 	local args=("${@:1}")
+	// 	declare -g -A flagValues=([verbose]="false")
+	// 	local -A flagTypes=([verbose]="bool")
+	// 	local -A flagShorts=([v]="verbose")
+	// 	local -A flagsGiven=()
+	// 	local i=0
+	// 	local arg
+	// 	local name
+	// 	local value
+	// 	local hasValue
+	// 	flagArgs=()
+	// 	while (( i < ${#args[@]} ))
+	// 	do
+	// 		arg="${args[i]}"
+	// 		i=$((i + 1))
+	// 		name=""
+	// 		hasValue="false"
+	// 		case "${arg}" in
+	// 			--)
+	// 				flagArgs+=("${args[@]:i}")
+	// 				break
+	// 				;;
+	// 			-h|--help)
+	// 				flagHelp
+	// 				exit 0
+	// 				;;
+	// 			--*=*)
+	// 				name="${arg%%=*}"
+	// 				name="${name#--}"
+	// 				value="${arg#*=}"
+	// 				hasValue="true"
+	// 				;;
+	// 			--?*)
+	// 				name="${arg#--}"
+	// 				;;
+	// 			-?)
+	// 				name="${flagShorts[${arg#-}]}"
+	// 				;;
+	// 			*)
+	// 				flagArgs+=("${arg}")
+	// 				continue
+	// 				;;
+	// 		esac
+	// 		if [[ -z ${name} || -z ${flagTypes[${name}]} ]]
+	// 		then
+	// 			flagUsageError "Unknown flag '${arg}'"
+	// 		fi
+	// 		if [[ ${flagTypes[${name}]} == "bool" ]]
+	// 		then
+	// 			if [[ ${hasValue} == "false" ]]
+	// 			then
+	// 				value="true"
+	// 			elif [[ ${value} != "true" && ${value} != "false" ]]
+	// 			then
+	// 				flagUsageError "Invalid value '${value}' for flag '--${name}'. Expected true or false"
+	// 			fi
+	// 		elif [[ ${hasValue} == "false" ]]
+	// 		then
+	// 			if (( i >= ${#args[@]} ))
+	// 			then
+	// 				flagUsageError "Flag '${arg}' requires a value"
+	// 			fi
+	// 			value="${args[i]}"
+	// 			i=$((i + 1))
+	// 		fi
+	// 		if [[ ${flagTypes[${name}]} == "int" && ! ${value} =~ ^-?[0-9]+$ ]]
+	// 		then
+	// 			flagUsageError "Invalid value '${value}' for flag '--${name}'. Expected an int"
+	// 		fi
+	// 		flagValues[${name}]="${value}"
+	// 		flagsGiven[${name}]="true"
+	// 	done
+	// }
+	//
+	// # User script
+	//
+	// # Parse the command-line flags
+	// parseFlags "$@"
+	// set -- "${flagArgs[@]}"
+	// tmpIndex=0
+	// flagBool "verbose" "v" "Print more output"
+	// verbose="${tmpBools[0]}"
+}
+
+// -------- Native function "flagEnum" -------- MARK: flagEnum
+
+func TestErrorFlagEnumWithDefaultNotInChoices(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = flagEnum("env", "e", "test", ["dev", "prod"], "Target environment");`)
+	expected := fmt.Errorf("test.scri:1:9: flagEnum() - The default 'test' is not one of the choices")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFlagEnumWithChoicesVar(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		str[] choices = ["dev", "prod"];
+		str s = flagEnum("env", "e", "dev", choices, "Target environment");`)
+	expected := fmt.Errorf("test.scri:3:11: flagEnum() - Parameter choices must be a literal")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFlagEnumWithInvalidChoice(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = flagEnum("env", "e", "dev", ["dev", "pre prod"], "Target environment");`)
+	expected := fmt.Errorf("test.scri:1:9: flagEnum() - The choices must be string literals starting with a letter and containing only letters, digits and dashes")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestFlagEnum(t *testing.T) {
+	code := `
+		str env = flagEnum("env", "e", "dev", ["dev", "staging", "prod"], "Target environment");
+		printLn(env);
+	`
+	output, err := execBashTest(t, code, "", "--env=prod")
+	if err != nil || output != "prod" {
+		t.Errorf("Expected: \"prod\", Got: \"%s\" (%v)", output, err)
+	}
+	output, _ = execBashTest(t, code, "", "-e", "stag")
+	expected := "test.scri.sh: Invalid value 'stag' for flag '--env'. Expected one of: dev staging prod\nTry 'test.scri.sh --help' for more information."
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "flagInt" -------- MARK: flagInt
+
+func TestErrorFlagIntWithStrDefault(t *testing.T) {
+	initTest()
+	err := transpileTest(`int i = flagInt("retries", "r", "3", "Number of retries");`)
+	expected := fmt.Errorf("test.scri:1:9: flagInt() - Parameter default must be an int or a variable of type int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFlagIntWithVarDefault(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		int defaultRetries = 3;
+		int i = flagInt("retries", "r", defaultRetries, "Number of retries");`)
+	expected := fmt.Errorf("test.scri:3:11: flagInt() - Parameter default must be a literal")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+// -------- Native function "flagIntRequired" -------- MARK: flagIntRequired
+
+func TestErrorFlagIntRequiredWithoutDescription(t *testing.T) {
+	initTest()
+	err := transpileTest(`int port = flagIntRequired("port", "p");`)
+	expected := fmt.Errorf("test.scri:1:12: Expected syntax: flagIntRequired(str name, str short, str description)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+// -------- Native function "flagStr" -------- MARK: flagStr
+
+func TestErrorFlagStrWithInvalidName(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = flagStr("--env", "e", "dev", "Target environment");`)
+	expected := fmt.Errorf("test.scri:1:9: flagStr() - Invalid flag name '--env'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFlagStrWithInvalidShort(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = flagStr("env", "en", "dev", "Target environment");`)
+	expected := fmt.Errorf("test.scri:1:9: flagStr() - Invalid short flag 'en'. Expected a single letter or an empty string")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFlagStrWithHelp(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = flagStr("help", "", "", "Help topic");`)
+	expected := fmt.Errorf("test.scri:1:9: flagStr() - The flags '--help' and '-h' are reserved for the generated help")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFlagStrAlreadyDeclared(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		str s = flagStr("env", "e", "dev", "Target environment");
+		bool b = flagBool("dry-run", "e", "Do not change anything");`)
+	expected := fmt.Errorf("test.scri:3:12: flagBool() - The flag '-e' is already declared")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_flagStr() {
+	initTestForPrintMode()
+	transpileTest(`
+	str env = flagStr("env", "e", "dev", "Target environment");
+	int retries = flagInt("retries", "", 3, "Number of retries");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # flagStr(str name, str short, str default, str description) str
+	// flagStr () {
+	// 	local name=$1
+	// 	local short=$2
+	// 	local default=$3
+	// 	local description=$4
+	// 	tmpStrs[${tmpIndex}]="${flagValues[${name}]}"
+	// }
+	//
+	// # flagInt(str name, str short, int default, str description) int
+	// flagInt () {
+	// 	local name=$1
+	// 	local short=$2
+	// 	local default=$3
+	// 	local description=$4
+	// 	tmpInts[${tmpIndex}]="${flagValues[${name}]}"
+	// }
+	//
+	// # flagHelp() void
+	// flagHelp () {
+	// 	echo "Usage: ${0##*/} [options] [arguments]"
+	// 	echo ""
+	// 	echo "Options:"
+	// 	echo '  -e, --env string   Target environment (default: dev)'
+	// 	echo '      --retries int  Number of retries (default: 3)'
+	// 	echo '  -h, --help         Show this help'
+	// }
+	//
+	// # flagUsageError(str message) void
+	// flagUsageError () {
+	// 	local message=$1
+	// 	echo "${0##*/}: ${message}" >&2
+	// 	echo "Try '${0##*/} --help' for more information." >&2
+	// 	exit 2
+	// }
+	//
+	// # parseFlags(str[] args) void
+	// parseFlags () {
+	// 	local args=("${@:1}")
+	// 	declare -g -A flagValues=([env]="dev" [retries]="3")
+	// 	local -A flagTypes=([env]="str" [retries]="int")
+	// 	local -A flagShorts=([e]="env")
+	// 	local -A flagsGiven=()
+	// 	local i=0
+	// 	local arg
+	// 	local name
+	// 	local value
+	// 	local hasValue
+	// 	flagArgs=()
+	// 	while (( i < ${#args[@]} ))
+	// 	do
+	// 		arg="${args[i]}"
+	// 		i=$((i + 1))
+	// 		name=""
+	// 		hasValue="false"
+	// 		case "${arg}" in
+	// 			--)
+	// 				flagArgs+=("${args[@]:i}")
+	// 				break
+	// 				;;
+	// 			-h|--help)
+	// 				flagHelp
+	// 				exit 0
+	// 				;;
+	// 			--*=*)
+	// 				name="${arg%%=*}"
+	// 				name="${name#--}"
+	// 				value="${arg#*=}"
+	// 				hasValue="true"
+	// 				;;
+	// 			--?*)
+	// 				name="${arg#--}"
+	// 				;;
+	// 			-?)
+	// 				name="${flagShorts[${arg#-}]}"
+	// 				;;
+	// 			*)
+	// 				flagArgs+=("${arg}")
+	// 				continue
+	// 				;;
+	// 		esac
+	// 		if [[ -z ${name} || -z ${flagTypes[${name}]} ]]
+	// 		then
+	// 			flagUsageError "Unknown flag '${arg}'"
+	// 		fi
+	// 		if [[ ${flagTypes[${name}]} == "bool" ]]
+	// 		then
+	// 			if [[ ${hasValue} == "false" ]]
+	// 			then
+	// 				value="true"
+	// 			elif [[ ${value} != "true" && ${value} != "false" ]]
+	// 			then
+	// 				flagUsageError "Invalid value '${value}' for flag '--${name}'. Expected true or false"
+	// 			fi
+	// 		elif [[ ${hasValue} == "false" ]]
+	// 		then
+	// 			if (( i >= ${#args[@]} ))
+	// 			then
+	// 				flagUsageError "Flag '${arg}' requires a value"
+	// 			fi
+	// 			value="${args[i]}"
+	// 			i=$((i + 1))
+	// 		fi
+	// 		if [[ ${flagTypes[${name}]} == "int" && ! ${value} =~ ^-?[0-9]+$ ]]
+	// 		then
+	// 			flagUsageError "Invalid value '${value}' for flag '--${name}'. Expected an int"
+	// 		fi
+	// 		flagValues[${name}]="${value}"
+	// 		flagsGiven[${name}]="true"
+	// 	done
+	// }
+	//
+	// # User script
+	//
+	// # Parse the command-line flags
+	// parseFlags "$@"
+	// set -- "${flagArgs[@]}"
+	// tmpIndex=0
+	// flagStr "env" "e" "dev" "Target environment"
+	// env="${tmpStrs[0]}"
+	// flagInt "retries" "" 3 "Number of retries"
+	// retries=${tmpInts[0]}
+}
+
+func TestFlagParser(t *testing.T) {
+	code := `
+		str env = flagStr("env", "e", "dev", "Target environment");
+		bool verbose = flagBool("verbose", "v", "Print more output");
+		int retries = flagInt("retries", "", 3, "Number of retries");
+		func main(str[] args) int {
+			printLn(env, verbose, retries);
+			for (str arg in args) {
+				printLn("[" + arg + "]");
+			}
+			return 0;
+		}
+	`
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{}, "dev false 3"},
+		{[]string{"-e", "prod", "-v"}, "prod true 3"},
+		{[]string{"--env=a b", "--verbose=false", "--retries", "-5"}, "a b false -5"},
+		{[]string{"first", "--retries=1", "--", "--env", "last"}, "dev false 1\n[first]\n[--env]\n[last]"},
+	}
+	for _, test := range tests {
+		output, err := execBashTest(t, code, "", test.args...)
+		if err != nil || output != test.expected {
+			t.Errorf("%v: Expected: \"%s\", Got: \"%s\" (%v)", test.args, test.expected, output, err)
+		}
+	}
+}
+
+func TestFlagParserErrors(t *testing.T) {
+	code := `
+		int retries = flagInt("retries", "r", 3, "Number of retries");
+		str token = flagStrRequired("token", "", "API token");
+	`
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{}, "Missing required flag '--token'"},
+		{[]string{"--token", "x", "-r", "many"}, "Invalid value 'many' for flag '--retries'. Expected an int"},
+		{[]string{"--token", "x", "--unknown"}, "Unknown flag '--unknown'"},
+		{[]string{"--token"}, "Flag '--token' requires a value"},
+	}
+	for _, test := range tests {
+		output, err := execBashTest(t, code, "", test.args...)
+		expected := fmt.Sprintf("test.scri.sh: %s\nTry 'test.scri.sh --help' for more information.", test.expected)
+		exitErr, ok := err.(*exec.ExitError)
+		if !ok || exitErr.ExitCode() != 2 || output != expected {
+			t.Errorf("%v: Expected: \"%s\", Got: \"%s\" (%v)", test.args, expected, output, err)
+		}
+	}
+}
+
+func TestFlagHelp(t *testing.T) {
+	output, err := execBashTest(t, `
+		str env = flagStr("env", "e", "dev", "Target environment");
+		bool verbose = flagBool("verbose", "v", "Print more output");
+		int port = flagIntRequired("port", "", "Port to listen on");
+		printLn("not reached");
+	`, "", "--help")
+	expected := `Usage: test.scri.sh [options] [arguments]
+
+Options:
+  -e, --env string  Target environment (default: dev)
+  -v, --verbose     Print more output
+      --port int    Port to listen on (required)
+  -h, --help        Show this help`
+	if err != nil || output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\" (%v)", expected, output, err)
+	}
+}
+
+func TestFlagHelpWithSpecialChars(t *testing.T) {
+	output, err := execBashTest(t, `
+		bool verbose = flagBool("verbose", "v", "Print \"it's\" $HOME \$PATH");
+	`, "", "--help")
+	expected := `Usage: test.scri.sh [options] [arguments]
+
+Options:
+  -v, --verbose  Print "it's" $HOME $PATH
+  -h, --help     Show this help`
+	if err != nil || output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\" (%v)", expected, output, err)
+	}
+}
+
+// -------- Completion -------- MARK: Completion
+
+func TestErrorCompletionWithInvalidCommand(t *testing.T) {
+	initTest()
+	err := transpileCompletionTest(`printLn("Hello");`, "my tool")
+	expected := fmt.Errorf("Invalid command name 'my tool' for the completion")
+	if err == nil || err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_completion() {
+	initTestForPrintMode()
+	transpileCompletionTest(`
+	str env = flagEnum("env", "e", "dev", ["dev", "prod"], "Target environment");
+	str output = flagStr("output", "", "out.txt", "Output file");
+	int retries = flagInt("retries", "r", 3, "Number of retries");
+	bool verbose = flagBool("verbose", "v", "Print more output");
+	`, "deploy-tool")
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # _deploy_tool_completion() void
+	// _deploy_tool_completion () {
+	// 	local cur="${COMP_WORDS[COMP_CWORD]}"
+	// 	local prev="${COMP_WORDS[COMP_CWORD - 1]}"
+	// 	COMPREPLY=()
+	// 	local prefix=""
+	// 	if [[ ${cur} == --*=* ]]
+	// 	then
+	// 		prev="${cur%%=*}"
+	// 		prefix="${prev}="
+	// 		cur="${cur#*=}"
+	// 	elif [[ ${cur} == "=" && ${prev} == --* ]]
+	// 	then
+	// 		cur=""
+	// 	elif [[ ${prev} == "=" && ${COMP_WORDS[COMP_CWORD - 2]} == --* ]]
+	// 	then
+	// 		prev="${COMP_WORDS[COMP_CWORD - 2]}"
+	// 	fi
+	// 	case "${prev}" in
+	// 		-e|--env)
+	// 			mapfile -t COMPREPLY < <(compgen -W "dev prod" -P "${prefix}" -- "${cur}")
+	// 			return
+	// 			;;
+	// 		--output)
+	// 			mapfile -t COMPREPLY < <(compgen -f -P "${prefix}" -- "${cur}")
+	// 			return
+	// 			;;
+	// 		-r|--retries)
+	// 			return
+	// 			;;
+	// 	esac
+	// 	if [[ ${cur} == -* ]]
+	// 	then
+	// 		mapfile -t COMPREPLY < <(compgen -W "--env -e --output --retries -r --verbose -v --help -h" -- "${cur}")
+	// 		return
+	// 	fi
+	// 	mapfile -t COMPREPLY < <(compgen -f -- "${cur}")
+	// }
+	//
+	// # User script
+	//
+	// complete -o filenames -F _deploy_tool_completion deploy-tool
+}
+
+func TestCompletion(t *testing.T) {
+	initTest()
+	testAssembler.testMode = false
+	config.Filename = filepath.Join(t.TempDir(), "test.scri")
+	defer func() { config.Filename = "test.scri" }()
+	err := transpileCompletionTest(`
+		str env = flagEnum("env", "e", "dev", ["dev", "staging", "prod"], "Target environment");
+		bool verbose = flagBool("verbose", "v", "Print more output");
+	`, "deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(filepath.Join(filepath.Dir(config.Filename), "notes.txt"), []byte{}, 0644)
+
+	tests := []struct {
+		words    string
+		expected string
+	}{
+		{`deploy --`, "--env --verbose --help"},
+		{`deploy -e s`, "staging"},
+		{`deploy --env ""`, "dev staging prod"},
+		{`deploy -v no`, "notes.txt"},
+		{`deploy --env=s`, "--env=staging"},
+		{`deploy --env = s`, "staging"},
+		{`deploy --env =`, "dev staging prod"},
+		{`deploy --verbose=no`, "notes.txt"},
+	}
+	for _, test := range tests {
+		script := fmt.Sprintf(`source "%s.completion.sh"; COMP_WORDS=(%s); COMP_CWORD=$(( ${#COMP_WORDS[@]} - 1 )); _deploy_completion; echo "${COMPREPLY[*]}"`, config.Filename, test.words)
+		cmd := exec.Command("bash", "-c", script)
+		cmd.Dir = filepath.Dir(config.Filename)
+		output, err := cmd.CombinedOutput()
+		if err != nil || strings.TrimSpace(string(output)) != test.expected {
+			t.Errorf("%s: Expected: \"%s\", Got: \"%s\" (%v)", test.words, test.expected, output, err)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "ceil" -------- MARK: ceil
+
+func TestErrorCeilWithInt(t *testing.T) {
+	initTest()
+	err := transpileTest(`int i = ceil(1);`)
+	expected := fmt.Errorf("test.scri:1:9: ceil() - Parameter value must be a float or a variable of type float. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_ceil() {
+	initTestForPrintMode()
+	transpileTest(`int i = ceil(1.2);`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # floatCalc(str lhs, str operator, str rhs) str
+	// floatCalc () {
+	// 	local lhs=$1
+	// 	local operator=$2
+	// 	local rhs=$3
+	// 	LC_ALL=C awk -v lhs="${lhs}" -v operator="${operator}" -v rhs="${rhs}" 'BEGIN {
+	// 		lhs += 0; rhs += 0
+	// 		if (operator == "<") exit !(lhs < rhs)
+	// 		if (operator == ">") exit !(lhs > rhs)
+	// 		if (operator == "<=") exit !(lhs <= rhs)
+	// 		if (operator == ">=") exit !(lhs >= rhs)
+	// 		if (operator == "==") exit !(lhs == rhs)
+	// 		if (operator == "!=") exit !(lhs != rhs)
+	// 		if (operator == "floor") { result = int(lhs); if (result > lhs) result--; printf "%d\n", result; exit }
+	// 		if (operator == "ceil") { result = int(lhs); if (result < lhs) result++; printf "%d\n", result; exit }
+	// 		if (operator == "round") { result = lhs < 0 ? -int(0.5 - lhs) : int(lhs + 0.5); printf "%d\n", result; exit }
+	// 		if (operator == "+") result = lhs + rhs
+	// 		if (operator == "-") result = lhs - rhs
+	// 		if (operator == "*") result = lhs * rhs
+	// 		if (operator == "/") result = lhs / rhs
+	// 		result = sprintf("%.15g", result)
+	// 		if (result !~ /[.eEnN]/) result = result ".0"
+	// 		print result
+	// 	}'
+	// }
+	//
+	// # ceil(float value) int
+	// ceil () {
+	// 	local value=$1
+	// 	tmpInts[${tmpIndex}]=$(floatCalc "${value}" "ceil" 0)
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// ceil 1.2
+	// i=${tmpInts[0]}
+}
+
+// -------- Native function "floatToStr" -------- MARK: floatToStr
+
+func TestErrorFloatToStrWithStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = floatToStr("1.5");`)
+	expected := fmt.Errorf("test.scri:1:9: floatToStr() - Parameter value must be a float or a variable of type float. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_floatToStr() {
+	initTestForPrintMode()
+	transpileTest(`str s = floatToStr(1.5 * 3);`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # floatCalc(str lhs, str operator, str rhs) str
+	// floatCalc () {
+	// 	local lhs=$1
+	// 	local operator=$2
+	// 	local rhs=$3
+	// 	LC_ALL=C awk -v lhs="${lhs}" -v operator="${operator}" -v rhs="${rhs}" 'BEGIN {
+	// 		lhs += 0; rhs += 0
+	// 		if (operator == "<") exit !(lhs < rhs)
+	// 		if (operator == ">") exit !(lhs > rhs)
+	// 		if (operator == "<=") exit !(lhs <= rhs)
+	// 		if (operator == ">=") exit !(lhs >= rhs)
+	// 		if (operator == "==") exit !(lhs == rhs)
+	// 		if (operator == "!=") exit !(lhs != rhs)
+	// 		if (operator == "floor") { result = int(lhs); if (result > lhs) result--; printf "%d\n", result; exit }
+	// 		if (operator == "ceil") { result = int(lhs); if (result < lhs) result++; printf "%d\n", result; exit }
+	// 		if (operator == "round") { result = lhs < 0 ? -int(0.5 - lhs) : int(lhs + 0.5); printf "%d\n", result; exit }
+	// 		if (operator == "+") result = lhs + rhs
+	// 		if (operator == "-") result = lhs - rhs
+	// 		if (operator == "*") result = lhs * rhs
+	// 		if (operator == "/") result = lhs / rhs
+	// 		result = sprintf("%.15g", result)
+	// 		if (result !~ /[.eEnN]/) result = result ".0"
+	// 		print result
+	// 	}'
+	// }
+	//
+	// # floatToStr(float value) str
+	// floatToStr () {
+	// 	local value=$1
+	// 	tmpStrs[${tmpIndex}]=${value}
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// floatToStr $(floatCalc "1.5" "*" "3")
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "floor" -------- MARK: floor
+
+func TestErrorFloorWithMissingArg(t *testing.T) {
+	initTest()
+	err := transpileTest(`int i = floor();`)
+	expected := fmt.Errorf("test.scri:1:9: Expected syntax: floor(float value)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_floor() {
+	initTestForPrintMode()
+	transpileTest(`float f = 1.8;
+		int i = floor(f);`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # floatCalc(str lhs, str operator, str rhs) str
+	// floatCalc () {
+	// 	local lhs=$1
+	// 	local operator=$2
+	// 	local rhs=$3
+	// 	LC_ALL=C awk -v lhs="${lhs}" -v operator="${operator}" -v rhs="${rhs}" 'BEGIN {
+	// 		lhs += 0; rhs += 0
+	// 		if (operator == "<") exit !(lhs < rhs)
+	// 		if (operator == ">") exit !(lhs > rhs)
+	// 		if (operator == "<=") exit !(lhs <= rhs)
+	// 		if (operator == ">=") exit !(lhs >= rhs)
+	// 		if (operator == "==") exit !(lhs == rhs)
+	// 		if (operator == "!=") exit !(lhs != rhs)
+	// 		if (operator == "floor") { result = int(lhs); if (result > lhs) result--; printf "%d\n", result; exit }
+	// 		if (operator == "ceil") { result = int(lhs); if (result < lhs) result++; printf "%d\n", result; exit }
+	// 		if (operator == "round") { result = lhs < 0 ? -int(0.5 - lhs) : int(lhs + 0.5); printf "%d\n", result; exit }
+	// 		if (operator == "+") result = lhs + rhs
+	// 		if (operator == "-") result = lhs - rhs
+	// 		if (operator == "*") result = lhs * rhs
+	// 		if (operator == "/") result = lhs / rhs
+	// 		result = sprintf("%.15g", result)
+	// 		if (result !~ /[.eEnN]/) result = result ".0"
+	// 		print result
+	// 	}'
+	// }
+	//
+	// # floor(float value) int
+	// floor () {
+	// 	local value=$1
+	// 	tmpInts[${tmpIndex}]=$(floatCalc "${value}" "floor" 0)
+	// }
+	//
+	// # User script
+	//
+	// f=1.8
+	// tmpIndex=0
+	// floor ${f}
+	// i=${tmpInts[0]}
+}
+
+// -------- Native function "round" -------- MARK: round
+
+func TestErrorRoundWithInt(t *testing.T) {
+	initTest()
+	err := transpileTest(`int i = round(2);`)
+	expected := fmt.Errorf("test.scri:1:9: round() - Parameter value must be a float or a variable of type float. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_round() {
+	initTestForPrintMode()
+	transpileTest(`int i = round(2.5);`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # floatCalc(str lhs, str operator, str rhs) str
+	// floatCalc () {
+	// 	local lhs=$1
+	// 	local operator=$2
+	// 	local rhs=$3
+	// 	LC_ALL=C awk -v lhs="${lhs}" -v operator="${operator}" -v rhs="${rhs}" 'BEGIN {
+	// 		lhs += 0; rhs += 0
+	// 		if (operator == "<") exit !(lhs < rhs)
+	// 		if (operator == ">") exit !(lhs > rhs)
+	// 		if (operator == "<=") exit !(lhs <= rhs)
+	// 		if (operator == ">=") exit !(lhs >= rhs)
+	// 		if (operator == "==") exit !(lhs == rhs)
+	// 		if (operator == "!=") exit !(lhs != rhs)
+	// 		if (operator == "floor") { result = int(lhs); if (result > lhs) result--; printf "%d\n", result; exit }
+	// 		if (operator == "ceil") { result = int(lhs); if (result < lhs) result++; printf "%d\n", result; exit }
+	// 		if (operator == "round") { result = lhs < 0 ? -int(0.5 - lhs) : int(lhs + 0.5); printf "%d\n", result; exit }
+	// 		if (operator == "+") result = lhs + rhs
+	// 		if (operator == "-") result = lhs - rhs
+	// 		if (operator == "*") result = lhs * rhs
+	// 		if (operator == "/") result = lhs / rhs
+	// 		result = sprintf("%.15g", result)
+	// 		if (result !~ /[.eEnN]/) result = result ".0"
+	// 		print result
+	// 	}'
+	// }
+	//
+	// # round(float value) int
+	// round () {
+	// 	local value=$1
+	// 	tmpInts[${tmpIndex}]=$(floatCalc "${value}" "round" 0)
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// round 2.5
+	// i=${tmpInts[0]}
+}
+
+// -------- Native function "strToFloat" -------- MARK: strToFloat
+
+func TestErrorStrToFloatWithFloat(t *testing.T) {
+	initTest()
+	err := transpileTest(`float f = strToFloat(1.5);`)
+	expected := fmt.Errorf("test.scri:1:11: strToFloat() - Parameter value must be a string or a variable of type string. Got 'FloatLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_strToFloat() {
+	initTestForPrintMode()
+	transpileTest(`float f = strToFloat("3.14");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # floatCalc(str lhs, str operator, str rhs) str
+	// floatCalc () {
+	// 	local lhs=$1
+	// 	local operator=$2
+	// 	local rhs=$3
+	// 	LC_ALL=C awk -v lhs="${lhs}" -v operator="${operator}" -v rhs="${rhs}" 'BEGIN {
+	// 		lhs += 0; rhs += 0
+	// 		if (operator == "<") exit !(lhs < rhs)
+	// 		if (operator == ">") exit !(lhs > rhs)
+	// 		if (operator == "<=") exit !(lhs <= rhs)
+	// 		if (operator == ">=") exit !(lhs >= rhs)
+	// 		if (operator == "==") exit !(lhs == rhs)
+	// 		if (operator == "!=") exit !(lhs != rhs)
+	// 		if (operator == "floor") { result = int(lhs); if (result > lhs) result--; printf "%d\n", result; exit }
+	// 		if (operator == "ceil") { result = int(lhs); if (result < lhs) result++; printf "%d\n", result; exit }
+	// 		if (operator == "round") { result = lhs < 0 ? -int(0.5 - lhs) : int(lhs + 0.5); printf "%d\n", result; exit }
+	// 		if (operator == "+") result = lhs + rhs
+	// 		if (operator == "-") result = lhs - rhs
+	// 		if (operator == "*") result = lhs * rhs
+	// 		if (operator == "/") result = lhs / rhs
+	// 		result = sprintf("%.15g", result)
+	// 		if (result !~ /[.eEnN]/) result = result ".0"
+	// 		print result
+	// 	}'
+	// }
+	//
+	// # strToFloat(str value) float
+	// strToFloat () {
+	// 	local value=$1
+	// 	tmpFloats[${tmpIndex}]=$(floatCalc "${value}" "+" 0)
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strToFloat "3.14"
+	// f=${tmpFloats[0]}
+}
@@ -0,0 +1,161 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "format" -------- MARK: format
+
+func TestErrorFormatWithoutArgs(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = format();`)
+	expected := fmt.Errorf("test.scri:1:9: Expected syntax: format(str fmt, values...)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFormatWithWrongFmtType(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = format(42);`)
+	expected := fmt.Errorf("test.scri:1:9: format() - Parameter fmt must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFormatWithTooFewValues(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = format("%s: %d", "answer");`)
+	expected := fmt.Errorf("test.scri:1:9: format() - The format contains 2 verbs but 1 values are given")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFormatWithTooManyValues(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = format("%s", "answer", 42);`)
+	expected := fmt.Errorf("test.scri:1:9: format() - The format contains 1 verbs but 2 values are given")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFormatWithVerbTypeMismatch(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		str answer = "42";
+		str s = format("%5d", answer);`)
+	expected := fmt.Errorf("test.scri:3:11: format() - Verb '%%5d' expects a value of type int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFormatWithFloatVerbAndStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = format("%5.2f", "1.5");`)
+	expected := fmt.Errorf("test.scri:1:9: format() - Verb '%%5.2f' expects a value of type float or int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFormatWithUnsupportedVerb(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = format("%q", "value");`)
+	expected := fmt.Errorf("test.scri:1:9: format() - Unsupported format verb '%%q'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFormatWithArrayValue(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		int[] ints = [1, 2];
+		str s = format("%d", ints);`)
+	expected := fmt.Errorf("test.scri:3:11: format() - Parameter values must be of type str, int, bool or float. Got 'IntArray'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_format() {
+	initTestForPrintMode()
+	transpileTest(`
+	float load = 1.5;
+	str s = format("%-10s|%5d|%6.2f|%b|100%%", "load", 42, load, true);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # format(str fmt, str[] values) str
+	// format () {
+	// 	local fmt=$1
+	// 	local values=("${@:2}")
+	// 	LC_NUMERIC=C printf -v "tmpStrs[${tmpIndex}]" "${fmt}" "${values[@]}"
+	// }
+	//
+	// # User script
+	//
+	// load=1.5
+	// tmpIndex=0
+	// format "%-10s|%5d|%6.2f|%b|100%%" "load" 42 ${load} "true"
+	// s="${tmpStrs[0]}"
+}
+
+func TestFormatOutput(t *testing.T) {
+	output := runBashTest(t, `
+		str fmt = "%s=%s";
+		printLn(format("%-6s|%3d|%6.2f|%b|%x|%%", "name", 7, 1.25, false, 255));
+		printLn(format(fmt, "key", "value with  spaces"));
+	`)
+	expected := "name  |  7|  1.25|false|ff|%\nkey=value with  spaces"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "printF" -------- MARK: printF
+
+func TestErrorPrintFWithVerbTypeMismatch(t *testing.T) {
+	initTest()
+	err := transpileTest(`printF("%b", 1);`)
+	expected := fmt.Errorf("test.scri:1:1: printF() - Verb '%%b' expects a value of type bool. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_printF() {
+	initTestForPrintMode()
+	transpileTest(`
+	str fmt = "%s\n";
+	printF("%-8s %5d\n", "total", 42);
+	printF(fmt, "done");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # printF(str fmt, str[] values) void
+	// printF () {
+	// 	local fmt=$1
+	// 	local values=("${@:2}")
+	// 	LC_NUMERIC=C printf "${fmt}" "${values[@]}"
+	// }
+	//
+	// # User script
+	//
+	// fmt="%s\n"
+	// printF "%-8s %5d\n" "total" 42
+	// printF "${fmt}" "done"
+}
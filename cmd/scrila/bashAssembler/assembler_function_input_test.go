@@ -0,0 +1,207 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "inputSecret" -------- MARK: inputSecret
+
+func TestErrorInputSecretWithIntArg(t *testing.T) {
+	initTest()
+	err := transpileTest(`inputSecret(42);`)
+	expected := fmt.Errorf("test.scri:1:1: inputSecret() - Parameter prompt must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_inputSecret() {
+	initTestForPrintMode()
+	transpileTest(`str password = inputSecret("Password:");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # inputSecret(str prompt) str
+	// inputSecret () {
+	// 	local prompt=$1
+	// 	read -r -s -p "${prompt} " "tmpStrs[${tmpIndex}]"
+	// 	if [[ -t 0 ]]
+	// 	then
+	// 		echo >&2
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// inputSecret "Password:"
+	// password="${tmpStrs[0]}"
+}
+
+// -------- Native function "inputTimeout" -------- MARK: inputTimeout
+
+func TestErrorInputTimeoutWithStrSeconds(t *testing.T) {
+	initTest()
+	err := transpileTest(`inputTimeout("Continue?", "10", "yes");`)
+	expected := fmt.Errorf("test.scri:1:1: inputTimeout() - Parameter seconds must be an int or a variable of type int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_inputTimeout() {
+	initTestForPrintMode()
+	transpileTest(`str answer = inputTimeout("Continue?", 10, "yes");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # inputTimeout(str prompt, int seconds, str default) str
+	// inputTimeout () {
+	// 	local prompt=$1
+	// 	local seconds=$2
+	// 	local default=$3
+	// 	if ! read -r -t "${seconds}" -p "${prompt} " "tmpStrs[${tmpIndex}]"
+	// 	then
+	// 		tmpStrs[${tmpIndex}]="${default}"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// inputTimeout "Continue?" 10 "yes"
+	// answer="${tmpStrs[0]}"
+}
+
+func TestInputTimeoutUsesDefaultAtEndOfInput(t *testing.T) {
+	output := runBashTest(t, `printLn(inputTimeout("Continue?", 1, "yes"));`)
+	if output != "yes" {
+		t.Errorf("Expected: \"yes\", Got: \"%s\"", output)
+	}
+}
+
+// -------- Native function "readAll" -------- MARK: readAll
+
+func TestErrorReadAllWithArg(t *testing.T) {
+	initTest()
+	err := transpileTest(`readAll("file.txt");`)
+	expected := fmt.Errorf("test.scri:1:1: Expected syntax: readAll()")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_readAll() {
+	initTestForPrintMode()
+	transpileTest(`str content = readAll();`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # readAll() str
+	// readAll () {
+	// 	tmpStrs[${tmpIndex}]="$(cat)"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// readAll
+	// content="${tmpStrs[0]}"
+}
+
+// -------- Native function "readLine" -------- MARK: readLine
+
+func TestErrorReadLineAssignToInt(t *testing.T) {
+	initTest()
+	err := transpileTest(`int i = readLine();`)
+	expected := fmt.Errorf("test.scri:1:9: Cannot assign a value of type 'StrLiteral' to a var of type 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_readLine() {
+	initTestForPrintMode()
+	transpileTest(`str line = readLine();`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # readLine() str
+	// readLine () {
+	// 	IFS= read -r "tmpStrs[${tmpIndex}]" || true
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// readLine
+	// line="${tmpStrs[0]}"
+}
+
+func TestReadLineAndReadAllFromPipe(t *testing.T) {
+	output := runBashTestWithStdin(t, `
+		str first = readLine();
+		str rest = readAll();
+		str after = readLine();
+		printLn("[" + first + "] [" + rest + "] [" + after + "]");
+	`, "  first line\nsecond\nthird\n")
+	expected := "[  first line] [second\nthird] []"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "stdinIsTerminal" -------- MARK: stdinIsTerminal
+
+func Example_stdinIsTerminal() {
+	initTestForPrintMode()
+	transpileTest(`
+	if (stdinIsTerminal()) {
+		printLn("interactive");
+	}
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # stdinIsTerminal() bool
+	// stdinIsTerminal () {
+	// 	if [[ -t 0 ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// stdinIsTerminal
+	// if [[ "${tmpBools[0]}" == "true" ]]
+	// then
+	// 	echo "interactive"
+	// fi
+}
+
+func TestStdinIsTerminalInPipe(t *testing.T) {
+	output := runBashTestWithStdin(t, `printLn(stdinIsTerminal());`, "data")
+	if output != "false" {
+		t.Errorf("Expected: \"false\", Got: \"%s\"", output)
+	}
+}
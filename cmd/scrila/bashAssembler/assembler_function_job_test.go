@@ -0,0 +1,617 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "jobResult" -------- MARK: jobResult
+
+func TestErrorJobResultWithInt(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = jobResult(42);`)
+	expected := fmt.Errorf("test.scri:1:9: jobResult() - Parameter job must be a variable of type job. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_jobResult() {
+	initTestForPrintMode()
+	transpileTest(`
+	func greet() str {
+		return "hello";
+	}
+	job j = spawn(greet);
+	str s = jobResult(j);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # spawn(func fn, str resultVar) job
+	// spawn () {
+	// 	local fn=$1
+	// 	local resultVar=$2
+	// 	local file
+	// 	file="$(mktemp)"
+	// 	(
+	// 		"${fn}"
+	// 		if [[ -n "${resultVar}" ]]
+	// 		then
+	// 			local -n result=${resultVar}
+	// 			printf '%s' "${result[${tmpIndex}]}" > "${file}"
+	// 		fi
+	// 	) &
+	// 	jobFiles[$!]="${file}"
+	// 	tmpInts[${tmpIndex}]=$!
+	// }
+	//
+	// # scrila_wait(job job) int
+	// scrila_wait () {
+	// 	local job=$1
+	// 	if [[ -n "${jobFiles[${job}]}" ]]
+	// 	then
+	// 		wait "${job}"
+	// 		jobExitCodes[${job}]=$?
+	// 		jobResults[${job}]="$(< "${jobFiles[${job}]}")"
+	// 		rm -f "${jobFiles[${job}]}"
+	// 		unset "jobFiles[${job}]"
+	// 	fi
+	// 	tmpInts[${tmpIndex}]=${jobExitCodes[${job}]}
+	// }
+	//
+	// # jobResult(job job) str
+	// jobResult () {
+	// 	local job=$1
+	// 	scrila_wait "${job}"
+	// 	tmpStrs[${tmpIndex}]="${jobResults[${job}]}"
+	// }
+	//
+	// # User script
+	//
+	// # greet() str
+	// greet () {
+	// 	tmpStrs[${tmpIndex}]="hello"
+	// 	return
+	// }
+	//
+	// tmpIndex=0
+	// spawn "greet" "tmpStrs"
+	// j=${tmpInts[0]}
+	// jobResult ${j}
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "kill" -------- MARK: kill
+
+func TestErrorKillWithStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`kill("1234");`)
+	expected := fmt.Errorf("test.scri:1:1: kill() - Parameter job must be a variable of type job. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_kill() {
+	initTestForPrintMode()
+	transpileTest(`
+	func longTask() void {
+		sleep(60);
+	}
+	job j = spawn(longTask);
+	kill(j);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # spawn(func fn, str resultVar) job
+	// spawn () {
+	// 	local fn=$1
+	// 	local resultVar=$2
+	// 	local file
+	// 	file="$(mktemp)"
+	// 	(
+	// 		"${fn}"
+	// 		if [[ -n "${resultVar}" ]]
+	// 		then
+	// 			local -n result=${resultVar}
+	// 			printf '%s' "${result[${tmpIndex}]}" > "${file}"
+	// 		fi
+	// 	) &
+	// 	jobFiles[$!]="${file}"
+	// 	tmpInts[${tmpIndex}]=$!
+	// }
+	//
+	// # scrila_kill(job job) void
+	// scrila_kill () {
+	// 	local job=$1
+	// 	local children
+	// 	children="$(pgrep -P "${job}")"
+	// 	kill -TERM "${job}" ${children} 2> /dev/null
+	// }
+	//
+	// # User script
+	//
+	// # longTask() void
+	// longTask () {
+	// 	sleep 60
+	// }
+	//
+	// tmpIndex=0
+	// spawn "longTask" ""
+	// j=${tmpInts[0]}
+	// scrila_kill ${j}
+}
+
+func TestKill(t *testing.T) {
+	output := runBashTest(t, `
+		func longTask() void {
+			sleep(60);
+		}
+		job j = spawn(longTask);
+		kill(j);
+		printLn(wait(j));
+	`)
+	expected := "143"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "parallelExitCodes" -------- MARK: parallelExitCodes
+
+func TestErrorParallelExitCodesWithArg(t *testing.T) {
+	initTest()
+	err := transpileTest(`int[] codes = parallelExitCodes(1);`)
+	expected := fmt.Errorf("test.scri:1:15: Expected syntax: parallelExitCodes()")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_parallelExitCodes() {
+	initTestForPrintMode()
+	transpileTest(`int[] codes = parallelExitCodes();`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # parallelExitCodes() int[]
+	// parallelExitCodes () {
+	// 	tmpInts=("${parallelCodes[@]}")
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// parallelExitCodes
+	// codes=("${tmpInts[@]}")
+}
+
+// -------- Native function "spawn" -------- MARK: spawn
+
+func TestErrorSpawnWithStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`job j = spawn("check");`)
+	expected := fmt.Errorf("test.scri:1:9: spawn() - Parameter fn must be a user defined function. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorSpawnWithParams(t *testing.T) {
+	initTest()
+	err := transpileTest(`func check(str host) bool {
+	return true;
+}
+job j = spawn(check);`)
+	expected := fmt.Errorf("test.scri:4:9: spawn() - Function 'check' must have 0 parameter(s). Got 1")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorSpawnReturnsArray(t *testing.T) {
+	initTest()
+	err := transpileTest(`func hosts() str[] {
+	return ["a"];
+}
+job j = spawn(hosts);`)
+	expected := fmt.Errorf("test.scri:4:9: spawn() - Function 'hosts' must return a bool, float, int, str or void. Got 'StrArray'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorSpawnChangesCapturedVar(t *testing.T) {
+	initTest()
+	err := transpileTest(`int count = 0;
+func check() void {
+	count = 1;
+}
+job j = spawn(check);`)
+	expected := fmt.Errorf("test.scri:5:9: spawn() - Function 'check' must not change the captured variable 'count' as it runs in a background job")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorSpawnChangesCapturedArrayInIf(t *testing.T) {
+	initTest()
+	err := transpileTest(`str[] hosts = [];
+func check() void {
+	if (true) {
+		hosts[] = "a";
+	}
+}
+job j = spawn(check);`)
+	expected := fmt.Errorf("test.scri:7:9: spawn() - Function 'check' must not change the captured variable 'hosts' as it runs in a background job")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorSpawnChangesCapturedVarInCalledFunc(t *testing.T) {
+	initTest()
+	err := transpileTest(`int counter = 0;
+func bump() void {
+	counter = counter + 1;
+}
+func indirect() void {
+	bump();
+}
+job j = spawn(indirect);`)
+	expected := fmt.Errorf("test.scri:8:9: spawn() - Function 'indirect' must not change the captured variable 'counter' as it runs in a background job")
+	if err == nil || err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorSpawnChangesCapturedVarInCallback(t *testing.T) {
+	initTest()
+	err := transpileTest(`int total = 0;
+func add(int value) int {
+	total = total + value;
+	return value;
+}
+func check() void {
+	int[] values = arrMap([1, 2], add);
+}
+job j = spawn(check);`)
+	expected := fmt.Errorf("test.scri:9:9: spawn() - Function 'check' must not change the captured variable 'total' as it runs in a background job")
+	if err == nil || err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestSpawnCallingFuncWithLocalVars(t *testing.T) {
+	initTest()
+	err := transpileTest(`func double(int value) int {
+	int result = value * 2;
+	value = 0;
+	return result;
+}
+func check() int {
+	return double(21);
+}
+job j = spawn(check);`)
+	if err != nil {
+		t.Errorf("Expected no error, Got: \"%s\"", err)
+	}
+}
+
+func TestErrorSpawnAssignToStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`func check() void {
+}
+str s = spawn(check);`)
+	expected := fmt.Errorf("test.scri:3:9: Cannot assign a value of type 'Job' to a var of type 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_spawn() {
+	initTestForPrintMode()
+	transpileTest(`
+	str host = "example.com";
+	func ping() int {
+		int count = 0;
+		count = 3;
+		exec("ping -c 1 " + host);
+		return lastExitCode();
+	}
+	job j = spawn(ping);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # exec(str command) str
+	// exec () {
+	// 	local command=$1
+	// 	tmpStrs[${tmpIndex}]=$(eval ${command})
+	// 	execExitCode=$?
+	// }
+	//
+	// # lastExitCode() int
+	// lastExitCode () {
+	// 	tmpInts[${tmpIndex}]=${execExitCode:-0}
+	// }
+	//
+	// # spawn(func fn, str resultVar) job
+	// spawn () {
+	// 	local fn=$1
+	// 	local resultVar=$2
+	// 	local file
+	// 	file="$(mktemp)"
+	// 	(
+	// 		"${fn}"
+	// 		if [[ -n "${resultVar}" ]]
+	// 		then
+	// 			local -n result=${resultVar}
+	// 			printf '%s' "${result[${tmpIndex}]}" > "${file}"
+	// 		fi
+	// 	) &
+	// 	jobFiles[$!]="${file}"
+	// 	tmpInts[${tmpIndex}]=$!
+	// }
+	//
+	// # User script
+	//
+	// host="example.com"
+	// # ping() int
+	// ping () {
+	// 	local count=0
+	// 	count=3
+	// 	tmpIndex=0
+	// 	exec "ping -c 1 ${host}"
+	// 	lastExitCode
+	// 	tmpInts[${tmpIndex}]=${tmpInts[0]}
+	// 	return
+	// }
+	//
+	// spawn "ping" "tmpInts"
+	// j=${tmpInts[0]}
+}
+
+func TestSpawn(t *testing.T) {
+	output := runBashTest(t, `
+		str host = "";
+		func check() str {
+			sleep(1);
+			return "checked " + host;
+		}
+		job[] jobs = [];
+		for (str h in ["alpha", "beta"]) {
+			host = h;
+			jobs[] = spawn(check);
+		}
+		for (job j in jobs) {
+			printLn(jobResult(j));
+		}
+	`)
+	expected := "checked alpha\nchecked beta"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "wait" -------- MARK: wait
+
+func TestErrorWaitWithInt(t *testing.T) {
+	initTest()
+	err := transpileTest(`int code = wait(1234);`)
+	expected := fmt.Errorf("test.scri:1:12: wait() - Parameter job must be a variable of type job. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_wait() {
+	initTestForPrintMode()
+	transpileTest(`
+	func backup() void {
+		exec("tar -czf /tmp/home.tar.gz /home");
+	}
+	job j = spawn(backup);
+	int code = wait(j);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # exec(str command) str
+	// exec () {
+	// 	local command=$1
+	// 	tmpStrs[${tmpIndex}]=$(eval ${command})
+	// 	execExitCode=$?
+	// }
+	//
+	// # spawn(func fn, str resultVar) job
+	// spawn () {
+	// 	local fn=$1
+	// 	local resultVar=$2
+	// 	local file
+	// 	file="$(mktemp)"
+	// 	(
+	// 		"${fn}"
+	// 		if [[ -n "${resultVar}" ]]
+	// 		then
+	// 			local -n result=${resultVar}
+	// 			printf '%s' "${result[${tmpIndex}]}" > "${file}"
+	// 		fi
+	// 	) &
+	// 	jobFiles[$!]="${file}"
+	// 	tmpInts[${tmpIndex}]=$!
+	// }
+	//
+	// # scrila_wait(job job) int
+	// scrila_wait () {
+	// 	local job=$1
+	// 	if [[ -n "${jobFiles[${job}]}" ]]
+	// 	then
+	// 		wait "${job}"
+	// 		jobExitCodes[${job}]=$?
+	// 		jobResults[${job}]="$(< "${jobFiles[${job}]}")"
+	// 		rm -f "${jobFiles[${job}]}"
+	// 		unset "jobFiles[${job}]"
+	// 	fi
+	// 	tmpInts[${tmpIndex}]=${jobExitCodes[${job}]}
+	// }
+	//
+	// # User script
+	//
+	// # backup() void
+	// backup () {
+	// 	tmpIndex=0
+	// 	exec "tar -czf /tmp/home.tar.gz /home"
+	// }
+	//
+	// spawn "backup" ""
+	// j=${tmpInts[0]}
+	// scrila_wait ${j}
+	// code=${tmpInts[0]}
+}
+
+func TestWait(t *testing.T) {
+	output := runBashTest(t, `
+		func fail() void {
+			exit(3);
+		}
+		func succeed() bool {
+			return true;
+		}
+		job failing = spawn(fail);
+		job succeeding = spawn(succeed);
+		printLn(wait(failing), "[" + jobResult(failing) + "]");
+		printLn(wait(succeeding), "[" + jobResult(succeeding) + "]");
+		printLn(wait(succeeding));
+	`)
+	expected := "3 []\n0 [true]\n0"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "waitAll" -------- MARK: waitAll
+
+func TestErrorWaitAllWithIntArray(t *testing.T) {
+	initTest()
+	err := transpileTest(`int[] pids = [1, 2];
+waitAll(pids);`)
+	expected := fmt.Errorf("test.scri:2:1: waitAll() - Parameter jobs must be an array of jobs or a variable of type job[]. Got 'IntArray'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_waitAll() {
+	initTestForPrintMode()
+	transpileTest(`
+	func check() bool {
+		return true;
+	}
+	job[] jobs = [];
+	jobs[] = spawn(check);
+	jobs[] = spawn(check);
+	waitAll(jobs);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # spawn(func fn, str resultVar) job
+	// spawn () {
+	// 	local fn=$1
+	// 	local resultVar=$2
+	// 	local file
+	// 	file="$(mktemp)"
+	// 	(
+	// 		"${fn}"
+	// 		if [[ -n "${resultVar}" ]]
+	// 		then
+	// 			local -n result=${resultVar}
+	// 			printf '%s' "${result[${tmpIndex}]}" > "${file}"
+	// 		fi
+	// 	) &
+	// 	jobFiles[$!]="${file}"
+	// 	tmpInts[${tmpIndex}]=$!
+	// }
+	//
+	// # scrila_wait(job job) int
+	// scrila_wait () {
+	// 	local job=$1
+	// 	if [[ -n "${jobFiles[${job}]}" ]]
+	// 	then
+	// 		wait "${job}"
+	// 		jobExitCodes[${job}]=$?
+	// 		jobResults[${job}]="$(< "${jobFiles[${job}]}")"
+	// 		rm -f "${jobFiles[${job}]}"
+	// 		unset "jobFiles[${job}]"
+	// 	fi
+	// 	tmpInts[${tmpIndex}]=${jobExitCodes[${job}]}
+	// }
+	//
+	// # waitAll(job[] jobs) void
+	// waitAll () {
+	// 	local jobs=("${@:1}")
+	// 	local job
+	// 	for job in "${jobs[@]}"
+	// 	do
+	// 		scrila_wait "${job}"
+	// 	done
+	// }
+	//
+	// # User script
+	//
+	// # check() bool
+	// check () {
+	// 	tmpBools[${tmpIndex}]="true"
+	// 	return
+	// }
+	//
+	// jobs=()
+	// tmpIndex=0
+	// spawn "check" "tmpBools"
+	// jobs+=(${tmpInts[0]})
+	// spawn "check" "tmpBools"
+	// jobs+=(${tmpInts[0]})
+	// waitAll "${jobs[@]}"
+}
+
+func TestWaitAll(t *testing.T) {
+	output := runBashTest(t, `
+		func slow() int {
+			sleep(1);
+			return 1;
+		}
+		job[] jobs = [];
+		jobs[] = spawn(slow);
+		jobs[] = spawn(slow);
+		jobs[] = spawn(slow);
+		waitAll(jobs);
+		for (job j in jobs) {
+			printLn(wait(j), jobResult(j));
+		}
+	`)
+	expected := "0 1\n0 1\n0 1"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
@@ -0,0 +1,184 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// -------- Native function "logDebug" -------- MARK: logDebug
+
+func TestErrorLogDebugWithoutMessage(t *testing.T) {
+	initTest()
+	err := transpileTest(`logDebug();`)
+	expected := fmt.Errorf("test.scri:1:1: Expected syntax: logDebug(str message)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorLogDebugWithWrongArgType(t *testing.T) {
+	initTest()
+	err := transpileTest(`logDebug(42);`)
+	expected := fmt.Errorf("test.scri:1:1: logDebug() - Parameter message must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_logDebug() {
+	initTestForPrintMode()
+	transpileTest(`logDebug("Connecting");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeLog(str level, str message) void
+	// nativeLog () {
+	// 	local level=$1
+	// 	local message=$2
+	// 	local levels="DEBUG INFO WARN ERROR"
+	// 	local minLevel="${SCRILA_LOG_LEVEL:-INFO}"
+	// 	minLevel="${minLevel^^}"
+	// 	if [[ " ${levels} " != *" ${minLevel} "* ]]
+	// 	then
+	// 		minLevel="INFO"
+	// 	fi
+	// 	# Levels from the minimum level upwards e.g. "WARN ERROR"
+	// 	local enabledLevels="${minLevel}${levels#*${minLevel}}"
+	// 	if [[ " ${enabledLevels} " == *" ${level} "* ]]
+	// 	then
+	// 		printf '%(%Y-%m-%d %H:%M:%S)T [%s] %s\n' -1 "${level}" "${message}" >&2
+	// 	fi
+	// }
+	//
+	// # logDebug(str message) void
+	// logDebug () {
+	// 	local message=$1
+	// 	nativeLog "DEBUG" "${message}"
+	// }
+	//
+	// # User script
+	//
+	// logDebug "Connecting"
+}
+
+// -------- Native function "logInfo" -------- MARK: logInfo
+
+func Example_logInfo() {
+	initTestForPrintMode()
+	transpileTest(`
+	logInfo("Started");
+	logInfo("Finished");
+	logError("Failed");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeLog(str level, str message) void
+	// nativeLog () {
+	// 	local level=$1
+	// 	local message=$2
+	// 	local levels="DEBUG INFO WARN ERROR"
+	// 	local minLevel="${SCRILA_LOG_LEVEL:-INFO}"
+	// 	minLevel="${minLevel^^}"
+	// 	if [[ " ${levels} " != *" ${minLevel} "* ]]
+	// 	then
+	// 		minLevel="INFO"
+	// 	fi
+	// 	# Levels from the minimum level upwards e.g. "WARN ERROR"
+	// 	local enabledLevels="${minLevel}${levels#*${minLevel}}"
+	// 	if [[ " ${enabledLevels} " == *" ${level} "* ]]
+	// 	then
+	// 		printf '%(%Y-%m-%d %H:%M:%S)T [%s] %s\n' -1 "${level}" "${message}" >&2
+	// 	fi
+	// }
+	//
+	// # logInfo(str message) void
+	// logInfo () {
+	// 	local message=$1
+	// 	nativeLog "INFO" "${message}"
+	// }
+	//
+	// # logError(str message) void
+	// logError () {
+	// 	local message=$1
+	// 	nativeLog "ERROR" "${message}"
+	// }
+	//
+	// # User script
+	//
+	// logInfo "Started"
+	// logInfo "Finished"
+	// logError "Failed"
+}
+
+func TestLogLevels(t *testing.T) {
+	output := runBashTest(t, `
+		logDebug("hidden by default");
+		logInfo("info");
+		setLogLevel("warn");
+		logInfo("hidden by warn");
+		logWarn("warn");
+		logError("error");
+		printLn("stdout");
+	`)
+	// Remove the timestamps
+	lines := strings.Split(output, "\n")
+	for i, line := range lines {
+		if _, rest, found := strings.Cut(line, " ["); found {
+			lines[i] = "[" + rest
+		}
+	}
+	expected := "[INFO] info\n[WARN] warn\n[ERROR] error\nstdout"
+	if strings.Join(lines, "\n") != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "setLogLevel" -------- MARK: setLogLevel
+
+func TestErrorSetLogLevelWithInvalidLevel(t *testing.T) {
+	initTest()
+	err := transpileTest(`setLogLevel("verbose");`)
+	expected := fmt.Errorf("test.scri:1:1: setLogLevel() - Invalid log level 'verbose'. Expected one of DEBUG, INFO, WARN, ERROR")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_setLogLevel() {
+	initTestForPrintMode()
+	transpileTest(`setLogLevel("debug");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # setLogLevel(str level) void
+	// setLogLevel () {
+	// 	local level=$1
+	// 	if [[ " DEBUG INFO WARN ERROR " != *" ${level^^} "* ]]
+	// 	then
+	// 		nativeError "setLogLevel() - Invalid log level '${level}'. Expected one of DEBUG, INFO, WARN, ERROR"
+	// 	fi
+	// 	SCRILA_LOG_LEVEL="${level^^}"
+	// }
+	//
+	// # User script
+	//
+	// setLogLevel "debug"
+}
@@ -0,0 +1,342 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "Abs" -------- MARK: Abs
+
+func TestErrorAbsWithoutValue(t *testing.T) {
+	initTest()
+	err := transpileTest(`abs();`)
+	expected := fmt.Errorf("test.scri:1:1: Expected syntax: abs(int value)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorAbsWithWrongArgType(t *testing.T) {
+	initTest()
+	err := transpileTest(`abs("1");`)
+	expected := fmt.Errorf("test.scri:1:1: abs() - Parameter value must be an int or a variable of type int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_abs() {
+	initTestForPrintMode()
+	transpileTest(`
+		int i = 0 - 5;
+		i = abs(i);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # abs(int value) int
+	// abs () {
+	// 	local value=$1
+	// 	tmpInts[${tmpIndex}]=$(( value < 0 ? -value : value ))
+	// }
+	//
+	// # User script
+	//
+	// i=$((0 - 5))
+	// tmpIndex=0
+	// abs ${i}
+	// i=${tmpInts[0]}
+}
+
+// -------- Native function "Clamp" -------- MARK: Clamp
+
+func TestErrorClampWithWrongArg2Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`clamp(1, 0, true);`)
+	expected := fmt.Errorf("test.scri:1:1: clamp() - Parameter max must be an int or a variable of type int. Got 'BoolLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_clamp() {
+	initTestForPrintMode()
+	transpileTest(`
+		int i = clamp(15, 0, 10);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # clamp(int value, int min, int max) int
+	// clamp () {
+	// 	local value=$1
+	// 	local min=$2
+	// 	local max=$3
+	// 	tmpInts[${tmpIndex}]=$(( value < min ? min : (value > max ? max : value) ))
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// clamp 15 0 10
+	// i=${tmpInts[0]}
+}
+
+// -------- Native function "Gcd" -------- MARK: Gcd
+
+func Example_gcd() {
+	initTestForPrintMode()
+	transpileTest(`
+		int i = gcd(12, 18);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # gcd(int a, int b) int
+	// gcd () {
+	// 	local a=$1
+	// 	local b=$2
+	// 	a=$(( a < 0 ? -a : a ))
+	// 	b=$(( b < 0 ? -b : b ))
+	// 	local rest
+	// 	while (( b != 0 ))
+	// 	do
+	// 		rest=$(( a % b ))
+	// 		a=${b}
+	// 		b=${rest}
+	// 	done
+	// 	tmpInts[${tmpIndex}]=${a}
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// gcd 12 18
+	// i=${tmpInts[0]}
+}
+
+// -------- Native function "Max" -------- MARK: Max
+
+func Example_max() {
+	initTestForPrintMode()
+	transpileTest(`
+		int i = max(1, 2);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # max(int a, int b) int
+	// max () {
+	// 	local a=$1
+	// 	local b=$2
+	// 	tmpInts[${tmpIndex}]=$(( a > b ? a : b ))
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// max 1 2
+	// i=${tmpInts[0]}
+}
+
+// -------- Native function "Min" -------- MARK: Min
+
+func TestErrorMinWithoutValue(t *testing.T) {
+	initTest()
+	err := transpileTest(`min(1);`)
+	expected := fmt.Errorf("test.scri:1:1: Expected syntax: min(int a, int b)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_min() {
+	initTestForPrintMode()
+	transpileTest(`
+		int i = min(1, 2);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # min(int a, int b) int
+	// min () {
+	// 	local a=$1
+	// 	local b=$2
+	// 	tmpInts[${tmpIndex}]=$(( a < b ? a : b ))
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// min 1 2
+	// i=${tmpInts[0]}
+}
+
+// -------- Native function "Pow" -------- MARK: Pow
+
+func Example_pow() {
+	initTestForPrintMode()
+	transpileTest(`
+		int i = pow(2, 10);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # pow(int base, int exponent) int
+	// pow () {
+	// 	local base=$1
+	// 	local exponent=$2
+	// 	if (( exponent < 0 ))
+	// 	then
+	// 		tmpInts[${tmpIndex}]=0
+	// 		return
+	// 	fi
+	// 	tmpInts[${tmpIndex}]=$(( base ** exponent ))
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// pow 2 10
+	// i=${tmpInts[0]}
+}
+
+// -------- Native function "Random" -------- MARK: Random
+
+func TestErrorRandomWithWrongArg1Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`random(1, "10");`)
+	expected := fmt.Errorf("test.scri:1:1: random() - Parameter hi must be an int or a variable of type int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_random() {
+	initTestForPrintMode()
+	transpileTest(`
+		randomSeed(42);
+		int i = random(1, 6);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # randomSeed(int seed) void
+	// randomSeed () {
+	// 	local seed=$1
+	// 	RANDOM=${seed}
+	// 	randomSeeded="true"
+	// }
+	//
+	// # random(int lo, int hi) int
+	// random () {
+	// 	local lo=$1
+	// 	local hi=$2
+	// 	if (( hi < lo ))
+	// 	then
+	// 		local swap=${lo}
+	// 		lo=${hi}
+	// 		hi=${swap}
+	// 	fi
+	// 	local value
+	// 	if [[ -z "${randomSeeded}" ]] && [[ -n "${SRANDOM}" ]]
+	// 	then
+	// 		value=${SRANDOM}
+	// 	else
+	// 		value=$(( (RANDOM << 15) | RANDOM ))
+	// 	fi
+	// 	tmpInts[${tmpIndex}]=$(( lo + value % (hi - lo + 1) ))
+	// }
+	//
+	// # User script
+	//
+	// randomSeed 42
+	// tmpIndex=0
+	// random 1 6
+	// i=${tmpInts[0]}
+}
+
+// -------- Native function "Sign" -------- MARK: Sign
+
+func Example_sign() {
+	initTestForPrintMode()
+	transpileTest(`
+		int i = sign(42);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # sign(int value) int
+	// sign () {
+	// 	local value=$1
+	// 	tmpInts[${tmpIndex}]=$(( (value > 0) - (value < 0) ))
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// sign 42
+	// i=${tmpInts[0]}
+}
+
+// -------- Native function "Sqrt" -------- MARK: Sqrt
+
+func Example_sqrt() {
+	initTestForPrintMode()
+	transpileTest(`
+		int i = sqrt(17);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # sqrt(int value) int
+	// sqrt () {
+	// 	local value=$1
+	// 	if (( value < 2 ))
+	// 	then
+	// 		tmpInts[${tmpIndex}]=$(( value < 0 ? 0 : value ))
+	// 		return
+	// 	fi
+	// 	local x=${value}
+	// 	local y=$(( (x + 1) / 2 ))
+	// 	while (( y < x ))
+	// 	do
+	// 		x=${y}
+	// 		y=$(( (x + value / x) / 2 ))
+	// 	done
+	// 	tmpInts[${tmpIndex}]=${x}
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// sqrt 17
+	// i=${tmpInts[0]}
+}
@@ -0,0 +1,319 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "pathAbs" -------- MARK: pathAbs
+
+func Example_pathAbs() {
+	initTestForPrintMode()
+	transpileTest(`str s = pathAbs("../file.txt");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # pathAbs(str path) str
+	// pathAbs () {
+	// 	local path=$1
+	// 	tmpStrs[${tmpIndex}]=$(realpath -m -- "${path}") || nativeError "pathAbs() - Cannot resolve path '${path}'"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// pathAbs "../file.txt"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "pathBase" -------- MARK: pathBase
+
+func TestErrorPathBaseWithInt(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = pathBase(42);`)
+	expected := fmt.Errorf("test.scri:1:9: pathBase() - Parameter path must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_pathBase() {
+	initTestForPrintMode()
+	transpileTest(`str s = pathBase("/usr/lib/");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # pathBase(str path) str
+	// pathBase () {
+	// 	local path=$1
+	// 	# Remove trailing slashes except for the root directory
+	// 	while [[ ${path} == */ && ${path} != / ]]
+	// 	do
+	// 		path=${path%/}
+	// 	done
+	// 	if [[ ${path} == / ]]
+	// 	then
+	// 		tmpStrs[${tmpIndex}]="/"
+	// 	else
+	// 		tmpStrs[${tmpIndex}]="${path##*/}"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// pathBase "/usr/lib/"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "pathDir" -------- MARK: pathDir
+
+func Example_pathDir() {
+	initTestForPrintMode()
+	transpileTest(`str s = pathDir("/usr/lib/");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # pathDir(str path) str
+	// pathDir () {
+	// 	local path=$1
+	// 	# Remove trailing slashes except for the root directory
+	// 	while [[ ${path} == */ && ${path} != / ]]
+	// 	do
+	// 		path=${path%/}
+	// 	done
+	// 	if [[ ${path} != */* ]]
+	// 	then
+	// 		tmpStrs[${tmpIndex}]="."
+	// 		return
+	// 	fi
+	// 	path=${path%/*}
+	// 	# Remove trailing slashes except for the root directory
+	// 	while [[ ${path} == */ && ${path} != / ]]
+	// 	do
+	// 		path=${path%/}
+	// 	done
+	// 	tmpStrs[${tmpIndex}]="${path:-/}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// pathDir "/usr/lib/"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "pathExt" -------- MARK: pathExt
+
+func Example_pathExt() {
+	initTestForPrintMode()
+	transpileTest(`str s = pathExt("archive.tar.gz");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # pathExt(str path) str
+	// pathExt () {
+	// 	local path=$1
+	// 	# Remove trailing slashes except for the root directory
+	// 	while [[ ${path} == */ && ${path} != / ]]
+	// 	do
+	// 		path=${path%/}
+	// 	done
+	// 	local name=${path##*/}
+	// 	local rest=${name#.}
+	// 	local ext=""
+	// 	if [[ ${rest} == *.* && -n ${rest##*.} ]]
+	// 	then
+	// 		ext=".${rest##*.}"
+	// 	fi
+	// 	tmpStrs[${tmpIndex}]="${ext}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// pathExt "archive.tar.gz"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "pathJoin" -------- MARK: pathJoin
+
+func TestErrorPathJoinWithoutArgs(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = pathJoin();`)
+	expected := fmt.Errorf("test.scri:1:9: Expected syntax: pathJoin(str parts...)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorPathJoinWithInt(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = pathJoin("/opt", 42);`)
+	expected := fmt.Errorf("test.scri:1:9: pathJoin() - Parameter parts must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_pathJoin() {
+	initTestForPrintMode()
+	transpileTest(`str s = pathJoin("/opt/", "/app", "bin");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # pathJoin(str[] parts) str
+	// pathJoin () {
+	// 	local parts=("${@:1}")
+	// 	local joined=""
+	// 	local part
+	// 	for part in "${parts[@]}"
+	// 	do
+	// 		if [[ -z ${part} ]]
+	// 		then
+	// 			continue
+	// 		fi
+	// 		if [[ -z ${joined} ]]
+	// 		then
+	// 			joined=${part}
+	// 		else
+	// 			joined="${joined%/}/${part#/}"
+	// 		fi
+	// 	done
+	// 	tmpStrs[${tmpIndex}]=${joined}
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// pathJoin "/opt/" "/app" "bin"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "pathRel" -------- MARK: pathRel
+
+func Example_pathRel() {
+	initTestForPrintMode()
+	transpileTest(`str s = pathRel("/usr/lib", "/usr/share");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # pathRel(str path, str base) str
+	// pathRel () {
+	// 	local path=$1
+	// 	local base=$2
+	// 	tmpStrs[${tmpIndex}]=$(realpath -m --relative-to="${base}" -- "${path}") || nativeError "pathRel() - Cannot resolve path '${path}' relative to '${base}'"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// pathRel "/usr/lib" "/usr/share"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "pathStem" -------- MARK: pathStem
+
+func Example_pathStem() {
+	initTestForPrintMode()
+	transpileTest(`str s = pathStem("archive.tar.gz");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # pathStem(str path) str
+	// pathStem () {
+	// 	local path=$1
+	// 	# Remove trailing slashes except for the root directory
+	// 	while [[ ${path} == */ && ${path} != / ]]
+	// 	do
+	// 		path=${path%/}
+	// 	done
+	// 	local name=${path##*/}
+	// 	local rest=${name#.}
+	// 	local ext=""
+	// 	if [[ ${rest} == *.* && -n ${rest##*.} ]]
+	// 	then
+	// 		ext=".${rest##*.}"
+	// 	fi
+	// 	tmpStrs[${tmpIndex}]="${name%"${ext}"}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// pathStem "archive.tar.gz"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Path edge cases -------- MARK: Path edge cases
+
+func TestPathEdgeCases(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{`printLn(pathBase("/"));`, "/"},
+		{`printLn(pathBase("//"));`, "/"},
+		{`printLn(pathBase("/usr/lib/"));`, "lib"},
+		{`printLn(pathBase("file.txt"));`, "file.txt"},
+		{`printLn(pathDir("/"));`, "/"},
+		{`printLn(pathDir("/usr"));`, "/"},
+		{`printLn(pathDir("/usr/lib/"));`, "/usr"},
+		{`printLn(pathDir("a//b"));`, "a"},
+		{`printLn(pathDir("file.txt"));`, "."},
+		{`printLn(pathExt("/"));`, ""},
+		{`printLn(pathExt("archive.tar.gz"));`, ".gz"},
+		{`printLn(pathExt("dir/file.txt/"));`, ".txt"},
+		{`printLn(pathExt(".bashrc"));`, ""},
+		{`printLn(pathExt("file."));`, ""},
+		{`printLn(pathStem("archive.tar.gz"));`, "archive.tar"},
+		{`printLn(pathStem("/opt/app.d/"));`, "app"},
+		{`printLn(pathStem(".bashrc"));`, ".bashrc"},
+		{`printLn(pathJoin("/opt/", "/app/", "bin"));`, "/opt/app/bin"},
+		{`printLn(pathJoin("/", "etc"));`, "/etc"},
+		{`printLn(pathJoin("", "etc", ""));`, "etc"},
+		{`printLn(pathRel("/usr/lib", "/usr/share"));`, "../lib"},
+		{`printLn(pathAbs("/usr/../etc/"));`, "/etc"},
+	}
+
+	for _, test := range tests {
+		if output := runBashTest(t, test.code); output != test.expected {
+			t.Errorf("%s - Expected: \"%s\", Got: \"%s\"", test.code, test.expected, output)
+		}
+	}
+}
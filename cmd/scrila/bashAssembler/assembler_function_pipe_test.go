@@ -0,0 +1,296 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+// -------- Native function "pipe" -------- MARK: pipe
+
+func TestErrorPipeWithArrayVar(t *testing.T) {
+	initTest()
+	err := transpileTest(`str[] commands = ["ls"];
+str s = pipe(commands);`)
+	expected := fmt.Errorf("test.scri:2:9: pipe() - Parameter commands must be an array literal")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorPipeWithEmptyArray(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = pipe([]);`)
+	expected := fmt.Errorf("test.scri:1:9: pipe() - Parameter commands must contain at least one command")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorPipeWithStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = pipe("ps aux | grep nginx");`)
+	expected := fmt.Errorf("test.scri:1:9: pipe() - Parameter commands must be an array of strings or a variable of type str[]. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_pipe() {
+	initTestForPrintMode()
+	transpileTest(`
+	str grep = "grep nginx";
+	str procs = pipe(["ps aux", grep]);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// grep="grep nginx"
+	// tmpIndex=0
+	// tmpStrs[${tmpIndex}]="$( (set -o pipefail; eval "ps aux" | eval "${grep}"))"
+	// execExitCode=$?
+	// procs="${tmpStrs[0]}"
+}
+
+func TestPipeReturnsExitCodeOfFailingCommand(t *testing.T) {
+	output := runBashTest(t, `
+		printLn(pipe(["printf 'a nginx\\nb apache\\nc nginx\\n'", "grep nginx", "wc -l"]));
+		printLn(lastExitCode());
+		str s = pipe(["false", "cat"]);
+		printLn(lastExitCode());
+		s = pipe(["echo ok", "grep missing"]);
+		printLn(lastExitCode());
+		exec("false");
+		printLn("done");
+	`)
+	expected := "2\n0\n1\n1\ndone"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "pipeAppendFile" -------- MARK: pipeAppendFile
+
+func TestErrorPipeAppendFileWithIntPath(t *testing.T) {
+	initTest()
+	err := transpileTest(`pipeAppendFile(["ls"], 42);`)
+	expected := fmt.Errorf("test.scri:1:1: pipeAppendFile() - Parameter path must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_pipeAppendFile() {
+	initTestForPrintMode()
+	transpileTest(`
+	pipeAppendFile(["date"], "log.txt");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// (set -o pipefail; eval "date") >> "log.txt"
+	// execExitCode=$?
+}
+
+// -------- Native function "pipeFromFile" -------- MARK: pipeFromFile
+
+func TestErrorPipeFromFileWithoutPath(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = pipeFromFile(["sort"]);`)
+	expected := fmt.Errorf("test.scri:1:9: Expected syntax: pipeFromFile(str[] commands, str path)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_pipeFromFile() {
+	initTestForPrintMode()
+	transpileTest(`
+	str sorted = pipeFromFile(["sort", "uniq"], "names.txt");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// tmpStrs[${tmpIndex}]="$( (set -o pipefail; eval "sort" | eval "uniq") < "names.txt")"
+	// execExitCode=$?
+	// sorted="${tmpStrs[0]}"
+}
+
+// -------- Native function "pipeRedirect" -------- MARK: pipeRedirect
+
+func TestErrorPipeRedirectWithRedirectionsVar(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		str[] redirections = [">", "out.txt"];
+		str s = pipeRedirect(["ls"], redirections);`)
+	expected := fmt.Errorf("test.scri:3:11: pipeRedirect() - Parameter redirections must be an array literal")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorPipeRedirectWithInvalidOperator(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = pipeRedirect(["ls"], ["<", "in.txt", "out.txt"]);`)
+	expected := fmt.Errorf("test.scri:1:9: pipeRedirect() - Expected a redirection operator literal (one of <, >, >>, 2>&1) at index 2")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorPipeRedirectWithOperatorVar(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		str operator = ">";
+		str s = pipeRedirect(["ls"], [operator, "out.txt"]);`)
+	expected := fmt.Errorf("test.scri:3:11: pipeRedirect() - Expected a redirection operator literal (one of <, >, >>, 2>&1) at index 0")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorPipeRedirectWithoutFile(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = pipeRedirect(["ls"], ["2>&1", ">>"]);`)
+	expected := fmt.Errorf("test.scri:1:9: pipeRedirect() - Redirection '>>' requires a file")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_pipeRedirect() {
+	initTestForPrintMode()
+	transpileTest(`
+	str log = "build.log";
+	pipeRedirect(["sort", "uniq"], ["<", "names.txt", ">", "unique.txt"]);
+	str errors = pipeRedirect(["make"], ["2>&1", ">>", log]);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// log="build.log"
+	// tmpIndex=0
+	// tmpStrs[${tmpIndex}]="$( (set -o pipefail; eval "sort" | eval "uniq") < "names.txt" > "unique.txt")"
+	// execExitCode=$?
+	// tmpStrs[${tmpIndex}]="$( (set -o pipefail; eval "make") 2>&1 >> "${log}")"
+	// execExitCode=$?
+	// errors="${tmpStrs[0]}"
+}
+
+func TestPipeRedirect(t *testing.T) {
+	dir := t.TempDir()
+	output := runBashTest(t, fmt.Sprintf(`
+		str inFile = "%s";
+		str outFile = "%s";
+		pipeToFile(["printf 'b\\na\\n'"], inFile);
+		str s = pipeRedirect(["sort"], ["<", inFile, ">", outFile]);
+		printLn("[" + s + "]", pipeFromFile(["cat"], outFile));
+		# stderr is captured as it is redirected to stdout before stdout is redirected to the file
+		s = pipeRedirect(["echo err >&2", "echo out"], ["2>&1", ">", outFile]);
+		printLn("[" + s + "]", pipeFromFile(["cat"], outFile));
+	`, filepath.Join(dir, "in.txt"), filepath.Join(dir, "out.txt")))
+	expected := "[] a\nb\n[err] out"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "pipeToFile" -------- MARK: pipeToFile
+
+func TestErrorPipeToFileAssignToStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = pipeToFile(["ls"], "out.txt");`)
+	expected := fmt.Errorf("test.scri:1:9: Cannot assign a value of type 'Void' to a var of type 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_pipeToFile() {
+	initTestForPrintMode()
+	transpileTest(`
+	str file = "processes.txt";
+	pipeToFile(["ps aux", "grep nginx"], file);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// file="processes.txt"
+	// (set -o pipefail; eval "ps aux" | eval "grep nginx") > "${file}"
+	// execExitCode=$?
+}
+
+func TestPipeRedirections(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "out.txt")
+	output := runBashTest(t, fmt.Sprintf(`
+		str file = "%s";
+		pipeToFile(["echo one", "tr a-z A-Z"], file);
+		pipeAppendFile(["echo two"], file);
+		pipeAppendFile(["echo three"], file);
+		printLn(pipeFromFile(["sort -r", "head -n 2"], file));
+		pipeToFile(["echo overwritten"], file);
+		printLn(pipeFromFile(["cat"], file));
+	`, file))
+	expected := "two\nthree\noverwritten"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "pipeWithStderr" -------- MARK: pipeWithStderr
+
+func TestErrorPipeWithStderrWithTwoArgs(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = pipeWithStderr(["ls"], "x");`)
+	expected := fmt.Errorf("test.scri:1:9: Expected syntax: pipeWithStderr(str[] commands)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_pipeWithStderr() {
+	initTestForPrintMode()
+	transpileTest(`
+	str output = pipeWithStderr(["make", "tail -n 5"]);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// tmpStrs[${tmpIndex}]="$( (set -o pipefail; eval "make" | eval "tail -n 5") 2>&1)"
+	// execExitCode=$?
+	// output="${tmpStrs[0]}"
+}
+
+func TestPipeWithStderr(t *testing.T) {
+	output := runBashTest(t, `
+		# The output on stderr is not captured by pipe
+		str s = pipe(["echo err >&2", "cat"]);
+		printLn("[" + s + "]");
+		s = pipeWithStderr(["echo err >&2", "cat"]);
+		printLn("[" + s + "]");
+	`)
+	expected := "err\n[]\n[err]"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
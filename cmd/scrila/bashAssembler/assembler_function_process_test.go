@@ -0,0 +1,546 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "execFull" -------- MARK: execFull
+
+func TestErrorExecFullWithInt(t *testing.T) {
+	initTest()
+	err := transpileTest(`execResult r = execFull(42);`)
+	expected := fmt.Errorf("test.scri:1:16: execFull() - Parameter command must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorExecFullAssignToStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = execFull("ls");`)
+	expected := fmt.Errorf("test.scri:1:9: Cannot assign a value of type 'ExecResult' to a var of type 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorExecFullUnknownField(t *testing.T) {
+	initTest()
+	err := transpileTest(`execResult r = execFull("ls");
+printLn(r.exitCode);`)
+	expected := fmt.Errorf("test.scri:2:11: Type 'execResult' has no field 'exitCode'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorExecFullFieldWrongType(t *testing.T) {
+	initTest()
+	err := transpileTest(`execResult r = execFull("ls");
+str code = r.code;`)
+	expected := fmt.Errorf("test.scri:2:12: Cannot assign a value of type 'IntLiteral' to a var of type 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorExecFullAssignField(t *testing.T) {
+	initTest()
+	err := transpileTest(`execResult r = execFull("ls");
+r.ok = true;`)
+	expected := fmt.Errorf("test.scri:2:3: Cannot assign a value to the field 'ok'. Fields are read-only")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFieldOfNonExecResult(t *testing.T) {
+	initTest()
+	err := transpileTest(`int i = 42;
+printLn(i.code);`)
+	expected := fmt.Errorf("test.scri:2:9: Variable 'i' of type 'IntLiteral' has no fields")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_execFull() {
+	initTestForPrintMode()
+	transpileTest(`
+	execResult result = execFull("ls /tmp");
+	if (result.ok) {
+		printLn(result.stdout);
+	}
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # execFull(str command) execResult
+	// execFull () {
+	// 	local command=$1
+	// 	local stderrFile
+	// 	stderrFile="$(mktemp)"
+	// 	local stdout
+	// 	stdout="$(eval "${command}" 2> "${stderrFile}")"
+	// 	execExitCode=$?
+	// 	local stderr
+	// 	stderr="$(< "${stderrFile}")"
+	// 	rm -f "${stderrFile}"
+	// 	local ok="false"
+	// 	if [[ ${execExitCode} -eq 0 ]]
+	// 	then
+	// 		ok="true"
+	// 	fi
+	// 	tmpStrs=("${stdout}" "${stderr}" "${execExitCode}" "${ok}")
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// execFull "ls /tmp"
+	// result=("${tmpStrs[@]}")
+	// if [[ ${result[3]} == "true" ]]
+	// then
+	// 	echo "${result[0]}"
+	// fi
+}
+
+func TestExecFullCapturesStdoutStderrAndCode(t *testing.T) {
+	output := runBashTest(t, `
+		execResult result = execFull("echo out; echo err >&2; exit 3");
+		printLn("stdout: " + result.stdout);
+		printLn("stderr: " + result.stderr);
+		int code = result.code;
+		printLn(code);
+		bool ok = result.ok;
+		printLn(ok);
+		result = execFull("true");
+		if (result.ok) {
+			printLn("ok");
+		}
+	`)
+	expected := "stdout: out\nstderr: err\n3\nfalse\nok"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "execTimeout" -------- MARK: execTimeout
+
+func TestErrorExecTimeoutWithStrSeconds(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = execTimeout("make", "10");`)
+	expected := fmt.Errorf("test.scri:1:9: execTimeout() - Parameter seconds must be an int or a variable of type int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorExecTimeoutWithZeroSeconds(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = execTimeout("make", 0);`)
+	expected := fmt.Errorf("test.scri:1:9: execTimeout() - Parameter seconds must be greater than 0")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_execTimeout() {
+	initTestForPrintMode()
+	transpileTest(`
+	str output = execTimeout("apt-get update", 60);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # execTimeout(str command, int seconds) str
+	// execTimeout () {
+	// 	local command=$1
+	// 	local seconds=$2
+	// 	tmpStrs[${tmpIndex}]="$(timeout --kill-after=1 "${seconds}" bash -c "${command}")"
+	// 	execExitCode=$?
+	// 	if [[ ${execExitCode} -eq 137 ]]
+	// 	then
+	// 		execExitCode=124
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// execTimeout "apt-get update" 60
+	// output="${tmpStrs[0]}"
+}
+
+func TestExecTimeout(t *testing.T) {
+	output := runBashTest(t, `
+		str output = execTimeout("sleep 5; echo late", 1);
+		printLn("[" + output + "]", lastExitCode());
+		output = execTimeout("echo fast; exit 3", 5);
+		printLn("[" + output + "]", lastExitCode());
+		output = execTimeout("trap '' TERM; sleep 5", 1);
+		printLn("[" + output + "]", lastExitCode());
+	`)
+	expected := "[] 124\n[fast] 3\n[] 124"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "lastExitCode" -------- MARK: lastExitCode
+
+func TestErrorLastExitCodeWithArg(t *testing.T) {
+	initTest()
+	err := transpileTest(`int i = lastExitCode(1);`)
+	expected := fmt.Errorf("test.scri:1:9: Expected syntax: lastExitCode()")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_lastExitCode() {
+	initTestForPrintMode()
+	transpileTest(`
+	str output = exec("grep root /etc/passwd");
+	int code = lastExitCode();
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # exec(str command) str
+	// exec () {
+	// 	local command=$1
+	// 	tmpStrs[${tmpIndex}]=$(eval ${command})
+	// 	execExitCode=$?
+	// }
+	//
+	// # lastExitCode() int
+	// lastExitCode () {
+	// 	tmpInts[${tmpIndex}]=${execExitCode:-0}
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// exec "grep root /etc/passwd"
+	// output="${tmpStrs[0]}"
+	// lastExitCode
+	// code=${tmpInts[0]}
+}
+
+func TestLastExitCode(t *testing.T) {
+	output := runBashTest(t, `
+		printLn(lastExitCode());
+		exec("exit 4");
+		printLn(lastExitCode());
+		execResult result = execFull("exit 5");
+		printLn(lastExitCode());
+		exec("true");
+		printLn(lastExitCode());
+	`)
+	expected := "0\n4\n5\n0"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "retry" -------- MARK: retry
+
+func TestErrorRetryWithZeroAttempts(t *testing.T) {
+	initTest()
+	err := transpileTest(`func fn() bool {
+	return true;
+}
+bool b = retry(0, 1, fn);`)
+	expected := fmt.Errorf("test.scri:4:10: retry() - Parameter attempts must be greater than 0")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorRetryWithCallbackParams(t *testing.T) {
+	initTest()
+	err := transpileTest(`func fn(int i) bool {
+	return true;
+}
+bool b = retry(3, 1, fn);`)
+	expected := fmt.Errorf("test.scri:4:10: retry() - Function 'fn' must have 0 parameter(s). Got 1")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorRetryWithCallbackReturningStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`func fn() str {
+	return "ok";
+}
+bool b = retry(3, 1, fn);`)
+	expected := fmt.Errorf("test.scri:4:10: retry() - Function 'fn' must return 'BoolLiteral'. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_retry() {
+	initTestForPrintMode()
+	transpileTest(`
+	func download() bool {
+		execResult result = execFull("curl -fsSO https://example.com/file.tar.gz");
+		return result.ok;
+	}
+	bool ok = retry(3, 5, download);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # execFull(str command) execResult
+	// execFull () {
+	// 	local command=$1
+	// 	local stderrFile
+	// 	stderrFile="$(mktemp)"
+	// 	local stdout
+	// 	stdout="$(eval "${command}" 2> "${stderrFile}")"
+	// 	execExitCode=$?
+	// 	local stderr
+	// 	stderr="$(< "${stderrFile}")"
+	// 	rm -f "${stderrFile}"
+	// 	local ok="false"
+	// 	if [[ ${execExitCode} -eq 0 ]]
+	// 	then
+	// 		ok="true"
+	// 	fi
+	// 	tmpStrs=("${stdout}" "${stderr}" "${execExitCode}" "${ok}")
+	// }
+	//
+	// # retry(int attempts, int delaySeconds, func fn) bool
+	// retry () {
+	// 	local attempts=$1
+	// 	local delaySeconds=$2
+	// 	local fn=$3
+	// 	local index=${tmpIndex}
+	// 	local attempt
+	// 	for ((attempt = 1; attempt <= attempts; attempt++))
+	// 	do
+	// 		"${fn}"
+	// 		if [[ "${tmpBools[${tmpIndex}]}" == "true" ]]
+	// 		then
+	// 			tmpIndex=${index}
+	// 			tmpBools[${tmpIndex}]="true"
+	// 			return
+	// 		fi
+	// 		if [[ ${attempt} -lt ${attempts} ]]
+	// 		then
+	// 			sleep "${delaySeconds}"
+	// 		fi
+	// 	done
+	// 	tmpIndex=${index}
+	// 	tmpBools[${tmpIndex}]="false"
+	// }
+	//
+	// # User script
+	//
+	// # download() bool
+	// download () {
+	// 	tmpIndex=0
+	// 	execFull "curl -fsSO https://example.com/file.tar.gz"
+	// 	local result=("${tmpStrs[@]}")
+	// 	tmpBools[${tmpIndex}]=${result[3]}
+	// 	return
+	// }
+	//
+	// retry 3 5 "download"
+	// ok="${tmpBools[0]}"
+}
+
+func TestRetry(t *testing.T) {
+	output := runBashTest(t, `
+		int calls = 0;
+		func succeedsThirdTime() bool {
+			calls = calls + 1;
+			return calls >= 3;
+		}
+		func fails() bool {
+			calls = calls + 1;
+			return false;
+		}
+		printLn(retry(5, 0, succeedsThirdTime), calls);
+		calls = 0;
+		printLn(retry(4, 0, fails), calls);
+	`)
+	expected := "true 3\nfalse 4"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "retryBackoff" -------- MARK: retryBackoff
+
+func TestErrorRetryBackoffWithoutCallback(t *testing.T) {
+	initTest()
+	err := transpileTest(`bool b = retryBackoff(3, 1);`)
+	expected := fmt.Errorf("test.scri:1:10: Expected syntax: retryBackoff(int attempts, int delaySeconds, func fn)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_retryBackoff() {
+	initTestForPrintMode()
+	transpileTest(`
+	func mountNfs() bool {
+		exec("mount /mnt/nfs");
+		return lastExitCode() == 0;
+	}
+	bool ok = retryBackoff(5, 1, mountNfs);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # exec(str command) str
+	// exec () {
+	// 	local command=$1
+	// 	tmpStrs[${tmpIndex}]=$(eval ${command})
+	// 	execExitCode=$?
+	// }
+	//
+	// # lastExitCode() int
+	// lastExitCode () {
+	// 	tmpInts[${tmpIndex}]=${execExitCode:-0}
+	// }
+	//
+	// # retryBackoff(int attempts, int delaySeconds, func fn) bool
+	// retryBackoff () {
+	// 	local attempts=$1
+	// 	local delaySeconds=$2
+	// 	local fn=$3
+	// 	local index=${tmpIndex}
+	// 	local attempt
+	// 	for ((attempt = 1; attempt <= attempts; attempt++))
+	// 	do
+	// 		"${fn}"
+	// 		if [[ "${tmpBools[${tmpIndex}]}" == "true" ]]
+	// 		then
+	// 			tmpIndex=${index}
+	// 			tmpBools[${tmpIndex}]="true"
+	// 			return
+	// 		fi
+	// 		if [[ ${attempt} -lt ${attempts} ]]
+	// 		then
+	// 			sleep "${delaySeconds}"
+	// 			delaySeconds=$((delaySeconds * 2))
+	// 		fi
+	// 	done
+	// 	tmpIndex=${index}
+	// 	tmpBools[${tmpIndex}]="false"
+	// }
+	//
+	// # User script
+	//
+	// # mountNfs() bool
+	// mountNfs () {
+	// 	tmpIndex=0
+	// 	exec "mount /mnt/nfs"
+	// 	lastExitCode
+	// 	if [[ ${tmpInts[0]} -eq 0 ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// 	return
+	// }
+	//
+	// retryBackoff 5 1 "mountNfs"
+	// ok="${tmpBools[0]}"
+}
+
+// -------- Native function "run" -------- MARK: run
+
+func TestErrorRunWithStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = run("ls -l");`)
+	expected := fmt.Errorf("test.scri:1:9: run() - Parameter argv must be an array of strings or a variable of type str[]. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorRunWithEmptyArray(t *testing.T) {
+	initTest()
+	err := transpileTest(`str s = run([]);`)
+	expected := fmt.Errorf("test.scri:1:9: run() - Parameter argv must contain at least the command")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_run() {
+	initTestForPrintMode()
+	transpileTest(`
+	str file = "my file.txt";
+	str content = run(["cat", "--", file]);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # run(str[] argv) str
+	// run () {
+	// 	local argv=("${@:1}")
+	// 	tmpStrs[${tmpIndex}]="$(command "${argv[@]}")"
+	// 	execExitCode=$?
+	// }
+	//
+	// # User script
+	//
+	// file="my file.txt"
+	// tmpIndex=0
+	// run "cat" "--" "${file}"
+	// content="${tmpStrs[0]}"
+}
+
+func TestRunDoesNotParseArgs(t *testing.T) {
+	output := runBashTest(t, `
+		str arg = "a  b; echo injected";
+		str[] argv = ["printf", "[%s]", arg];
+		printLn(run(argv), lastExitCode());
+		run(["false"]);
+		printLn(lastExitCode());
+	`)
+	expected := "[a  b; echo injected] 0\n1"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+func TestRunDoesNotCallUserFunc(t *testing.T) {
+	output := runBashTest(t, `
+		func printf(str s) void {
+			printLn("shadowed");
+		}
+		printLn(run(["printf", "program"]));
+	`)
+	expected := "program"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
@@ -0,0 +1,188 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "ReFind" -------- MARK: ReFind
+
+func TestErrorReFindWithWrongArg0Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`reFind(1, "[0-9]");`)
+	expected := fmt.Errorf("test.scri:1:1: reFind() - Parameter value must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_reFind() {
+	initTestForPrintMode()
+	transpileTest(`
+		str[] groups = reFind("2024-01-02", "([0-9]+)-([0-9]+)");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # reFind(str value, str pattern) str
+	// reFind () {
+	// 	local value=$1
+	// 	local pattern=$2
+	// 	if [[ ${value} =~ ${pattern} ]]
+	// 	then
+	// 		tmpStrs=("${BASH_REMATCH[@]}")
+	// 	else
+	// 		tmpStrs=()
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// reFind "2024-01-02" "([0-9]+)-([0-9]+)"
+	// groups=("${tmpStrs[@]}")
+}
+
+// -------- Native function "ReMatch" -------- MARK: ReMatch
+
+func TestErrorReMatchWithoutPattern(t *testing.T) {
+	initTest()
+	err := transpileTest(`reMatch("abc");`)
+	expected := fmt.Errorf("test.scri:1:1: Expected syntax: reMatch(str value, str pattern)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorReMatchWithInvalidPattern(t *testing.T) {
+	initTest()
+	err := transpileTest(`reMatch("abc", "(a");`)
+	expected := fmt.Errorf("test.scri:1:1: reMatch() - Parameter pattern is not a valid extended regular expression: error parsing regexp: missing closing ): `(a`")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorReMatchWithPerlPattern(t *testing.T) {
+	initTest()
+	err := transpileTest(`reMatch("123", "\\d+");`)
+	expected := fmt.Errorf("test.scri:1:1: reMatch() - Parameter pattern is not a valid extended regular expression: error parsing regexp: invalid escape sequence: `\\d`")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_reMatch() {
+	initTestForPrintMode()
+	transpileTest(`
+		str pattern = "^[a-z]+\\s[0-9]+$";
+		bool isMatch = reMatch("abc 123", pattern);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # reMatch(str value, str pattern) bool
+	// reMatch () {
+	// 	local value=$1
+	// 	local pattern=$2
+	// 	if [[ ${value} =~ ${pattern} ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// pattern="^[a-z]+\\s[0-9]+$"
+	// tmpIndex=0
+	// reMatch "abc 123" "${pattern}"
+	// isMatch="${tmpBools[0]}"
+}
+
+// -------- Native function "ReReplace" -------- MARK: ReReplace
+
+func TestErrorReReplaceWithWrongArg2Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`reReplace("abc", "b", 1);`)
+	expected := fmt.Errorf("test.scri:1:1: reReplace() - Parameter replacement must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_reReplace() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = reReplace("a1b22", "[0-9]+", "#");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # reReplace(str value, str pattern, str replacement) str
+	// reReplace () {
+	// 	local value=$1
+	// 	local pattern=$2
+	// 	local replacement=$3
+	// 	local regex="(${pattern})(.*)$"
+	// 	local replaced=""
+	// 	local rest="${value}"
+	// 	local afterMatch=false
+	// 	local finished=false
+	// 	while [[ ${finished} == false ]] && [[ ${rest} =~ ${regex} ]]
+	// 	do
+	// 		local match="${BASH_REMATCH[1]}"
+	// 		local suffix="${BASH_REMATCH[-1]}"
+	// 		local prefix="${rest:0:${#rest}-${#BASH_REMATCH[0]}}"
+	// 		if [[ -n "${match}" || -n "${prefix}" || ${afterMatch} == false ]]
+	// 		then
+	// 			replaced+="${prefix}${replacement}"
+	// 		fi
+	// 		afterMatch=true
+	// 		if [[ -z "${match}" ]]
+	// 		then
+	// 			afterMatch=false
+	// 			if [[ -z "${suffix}" ]]
+	// 			then
+	// 				finished=true
+	// 			fi
+	// 			replaced+="${suffix:0:1}"
+	// 			suffix="${suffix:1}"
+	// 		fi
+	// 		rest="${suffix}"
+	// 		if [[ "${pattern}" == "^"* ]]
+	// 		then
+	// 			finished=true
+	// 		fi
+	// 	done
+	// 	tmpStrs[${tmpIndex}]="${replaced}${rest}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// reReplace "a1b22" "[0-9]+" "#"
+	// s="${tmpStrs[0]}"
+}
+
+func TestReReplaceWithEmptyMatches(t *testing.T) {
+	output := runBashTest(t, `
+		printLn(reReplace("abc", "x*", "-"));
+		printLn(reReplace("abc", "b*", "-"));
+		printLn(reReplace("", "x*", "-"));
+	`)
+	expected := "-a-b-c-\n-a-c-\n-"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
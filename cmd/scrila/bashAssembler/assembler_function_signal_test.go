@@ -0,0 +1,157 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "ignoreSignal" -------- MARK: ignoreSignal
+
+func TestErrorIgnoreSignalWithIntArg(t *testing.T) {
+	initTest()
+	err := transpileTest(`ignoreSignal(1);`)
+	expected := fmt.Errorf("test.scri:1:1: ignoreSignal() - Parameter signal must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorIgnoreSignalWithExit(t *testing.T) {
+	initTest()
+	err := transpileTest(`ignoreSignal("EXIT");`)
+	expected := fmt.Errorf("test.scri:1:1: ignoreSignal() - Invalid signal 'EXIT'. Expected one of HUP, INT, QUIT, TERM, USR1, USR2")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_ignoreSignal() {
+	initTestForPrintMode()
+	transpileTest(`ignoreSignal("INT");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// trap '' INT
+}
+
+func TestIgnoreSignal(t *testing.T) {
+	output := runBashTest(t, `
+		ignoreSignal("TERM");
+		exec("kill -TERM $$");
+		printLn("still running");
+	`)
+	expected := "still running"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "onSignal" -------- MARK: onSignal
+
+func TestErrorOnSignalWithKill(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func handler() void {}
+		onSignal("KILL", handler);
+	`)
+	expected := fmt.Errorf("test.scri:3:3: onSignal() - Invalid signal 'KILL'. Expected one of HUP, INT, QUIT, TERM, USR1, USR2, EXIT")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorOnSignalWithVar(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func handler() void {}
+		str signal = "INT";
+		onSignal(signal, handler);
+	`)
+	expected := fmt.Errorf("test.scri:4:3: onSignal() - Parameter signal must be a literal")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorOnSignalWithStrHandler(t *testing.T) {
+	initTest()
+	err := transpileTest(`onSignal("INT", "handler");`)
+	expected := fmt.Errorf("test.scri:1:1: onSignal() - Parameter handler must be a user defined function. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorOnSignalWithHandlerParam(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func handler(int code) void {}
+		onSignal("INT", handler);
+	`)
+	expected := fmt.Errorf("test.scri:3:3: onSignal() - Function 'handler' must have 0 parameter(s). Got 1")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorOnSignalWithHandlerReturn(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func handler() int {
+			return 1;
+		}
+		onSignal("INT", handler);
+	`)
+	expected := fmt.Errorf("test.scri:5:3: onSignal() - Function 'handler' must return 'Void'. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_onSignal() {
+	initTestForPrintMode()
+	transpileTest(`
+	func cleanup() void {
+		printLn("Shutting down");
+		exit(1);
+	}
+	onSignal("INT", cleanup);
+	onSignal("TERM", cleanup);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// # cleanup() void
+	// cleanup () {
+	// 	echo "Shutting down"
+	// 	exit 1
+	// }
+	//
+	// trap 'cleanup' INT
+	// trap 'cleanup' TERM
+}
+
+func TestOnSignal(t *testing.T) {
+	output := runBashTest(t, `
+		func cleanup() void {
+			printLn("cleanup");
+		}
+		func bye() void {
+			printLn("bye");
+		}
+		onSignal("USR1", cleanup);
+		onSignal("EXIT", bye);
+		exec("kill -USR1 $$");
+		printLn("done");
+	`)
+	expected := "cleanup\ndone\nbye"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
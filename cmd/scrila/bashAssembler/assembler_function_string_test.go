@@ -0,0 +1,561 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "StrCount" -------- MARK: StrCount
+
+func TestErrorStrCountWithWrongArg1Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`strCount("a,b", 1);`)
+	expected := fmt.Errorf("test.scri:1:1: strCount() - Parameter substring must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_strCount() {
+	initTestForPrintMode()
+	transpileTest(`
+		int count = strCount("a,b,c", ",");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strCount(str value, str substring) int
+	// strCount () {
+	// 	local value=$1
+	// 	local substring=$2
+	// 	if [[ -z "${substring}" ]]
+	// 	then
+	// 		tmpInts[${tmpIndex}]=0
+	// 		return
+	// 	fi
+	// 	local rest="${value//"${substring}"}"
+	// 	tmpInts[${tmpIndex}]=$(( (${#value} - ${#rest}) / ${#substring} ))
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strCount "a,b,c" ","
+	// count=${tmpInts[0]}
+}
+
+// -------- Native function "StrIndexOf" -------- MARK: StrIndexOf
+
+func TestErrorStrIndexOfWithoutValue(t *testing.T) {
+	initTest()
+	err := transpileTest(`strIndexOf("abc");`)
+	expected := fmt.Errorf("test.scri:1:1: Expected syntax: strIndexOf(str value, str substring)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_strIndexOf() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = "abcabc";
+		int index = strIndexOf(s, "bc");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strIndexOf(str value, str substring) int
+	// strIndexOf () {
+	// 	local value=$1
+	// 	local substring=$2
+	// 	if [[ "${value}" != *"${substring}"* ]]
+	// 	then
+	// 		tmpInts[${tmpIndex}]=-1
+	// 		return
+	// 	fi
+	// 	local prefix="${value%%"${substring}"*}"
+	// 	tmpInts[${tmpIndex}]=${#prefix}
+	// }
+	//
+	// # User script
+	//
+	// s="abcabc"
+	// tmpIndex=0
+	// strIndexOf "${s}" "bc"
+	// index=${tmpInts[0]}
+}
+
+// -------- Native function "StrLastIndexOf" -------- MARK: StrLastIndexOf
+
+func Example_strLastIndexOf() {
+	initTestForPrintMode()
+	transpileTest(`
+		int index = strLastIndexOf("abcabc", "bc");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strLastIndexOf(str value, str substring) int
+	// strLastIndexOf () {
+	// 	local value=$1
+	// 	local substring=$2
+	// 	if [[ "${value}" != *"${substring}"* ]]
+	// 	then
+	// 		tmpInts[${tmpIndex}]=-1
+	// 		return
+	// 	fi
+	// 	local prefix="${value%"${substring}"*}"
+	// 	tmpInts[${tmpIndex}]=${#prefix}
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strLastIndexOf "abcabc" "bc"
+	// index=${tmpInts[0]}
+}
+
+// -------- Native function "StrLen" -------- MARK: StrLen
+
+func TestErrorStrLenWithWrongArgType(t *testing.T) {
+	initTest()
+	err := transpileTest(`strLen(123);`)
+	expected := fmt.Errorf("test.scri:1:1: strLen() - Parameter value must be a string or a variable of type string. Got 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_strLen() {
+	initTestForPrintMode()
+	transpileTest(`
+		int len = strLen("abc");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strLen(str value) int
+	// strLen () {
+	// 	local value=$1
+	// 	tmpInts[${tmpIndex}]=${#value}
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strLen "abc"
+	// len=${tmpInts[0]}
+}
+
+// -------- Native function "StrPadLeft" -------- MARK: StrPadLeft
+
+func TestErrorStrPadLeftWithWrongArg1Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`strPadLeft("7", "3", "0");`)
+	expected := fmt.Errorf("test.scri:1:1: strPadLeft() - Parameter length must be an int or a variable of type int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_strPadLeft() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = strPadLeft("7", 3, "0");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strPadLeft(str value, int length, str padding) str
+	// strPadLeft () {
+	// 	local value=$1
+	// 	local length=$2
+	// 	local padding=$3
+	// 	local pad=""
+	// 	if [[ -n "${padding}" ]] && [[ ${#value} -lt ${length} ]]
+	// 	then
+	// 		while [[ $(( ${#pad} + ${#value} )) -lt ${length} ]]
+	// 		do
+	// 			pad+="${padding}"
+	// 		done
+	// 		pad="${pad:0:$(( ${length} - ${#value} ))}"
+	// 	fi
+	// 	tmpStrs[${tmpIndex}]="${pad}${value}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strPadLeft "7" 3 "0"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "StrPadRight" -------- MARK: StrPadRight
+
+func Example_strPadRight() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = strPadRight("ab", 5, ".");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strPadRight(str value, int length, str padding) str
+	// strPadRight () {
+	// 	local value=$1
+	// 	local length=$2
+	// 	local padding=$3
+	// 	local pad=""
+	// 	if [[ -n "${padding}" ]] && [[ ${#value} -lt ${length} ]]
+	// 	then
+	// 		while [[ $(( ${#pad} + ${#value} )) -lt ${length} ]]
+	// 		do
+	// 			pad+="${padding}"
+	// 		done
+	// 		pad="${pad:0:$(( ${length} - ${#value} ))}"
+	// 	fi
+	// 	tmpStrs[${tmpIndex}]="${value}${pad}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strPadRight "ab" 5 "."
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "StrRepeat" -------- MARK: StrRepeat
+
+func TestErrorStrRepeatWithWrongArg1Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`strRepeat("ab", true);`)
+	expected := fmt.Errorf("test.scri:1:1: strRepeat() - Parameter count must be an int or a variable of type int. Got 'BoolLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_strRepeat() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = strRepeat("ab", 3);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strRepeat(str value, int count) str
+	// strRepeat () {
+	// 	local value=$1
+	// 	local count=$2
+	// 	local repeated=""
+	// 	local i
+	// 	for (( i=0; i<${count}; i++ ))
+	// 	do
+	// 		repeated+="${value}"
+	// 	done
+	// 	tmpStrs[${tmpIndex}]="${repeated}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strRepeat "ab" 3
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "StrReplace" -------- MARK: StrReplace
+
+func TestErrorStrReplaceWithoutReplacement(t *testing.T) {
+	initTest()
+	err := transpileTest(`strReplace("a.b", ".");`)
+	expected := fmt.Errorf("test.scri:1:1: Expected syntax: strReplace(str value, str search, str replacement)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_strReplace() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = strReplace("a.b.c", ".", "-");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strReplace(str value, str search, str replacement) str
+	// strReplace () {
+	// 	local value=$1
+	// 	local search=$2
+	// 	local replacement=$3
+	// 	tmpStrs[${tmpIndex}]="${value/"${search}"/"${replacement}"}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strReplace "a.b.c" "." "-"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "StrReplaceAll" -------- MARK: StrReplaceAll
+
+func Example_strReplaceAll() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = strReplaceAll("a.b.c", ".", "-");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strReplaceAll(str value, str search, str replacement) str
+	// strReplaceAll () {
+	// 	local value=$1
+	// 	local search=$2
+	// 	local replacement=$3
+	// 	tmpStrs[${tmpIndex}]="${value//"${search}"/"${replacement}"}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strReplaceAll "a.b.c" "." "-"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "StrReverse" -------- MARK: StrReverse
+
+func Example_strReverse() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = strReverse("abc");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strReverse(str value) str
+	// strReverse () {
+	// 	local value=$1
+	// 	local reversed=""
+	// 	local i
+	// 	for (( i=${#value}-1; i>=0; i-- ))
+	// 	do
+	// 		reversed+="${value:i:1}"
+	// 	done
+	// 	tmpStrs[${tmpIndex}]="${reversed}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strReverse "abc"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "StrSub" -------- MARK: StrSub
+
+func TestErrorStrSubWithWrongArg2Type(t *testing.T) {
+	initTest()
+	err := transpileTest(`strSub("abc", 0, "1");`)
+	expected := fmt.Errorf("test.scri:1:1: strSub() - Parameter length must be an int or a variable of type int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_strSub() {
+	initTestForPrintMode()
+	transpileTest(`
+		int start = 1;
+		str s = strSub("abcdef", start, 3);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strSub(str value, int start, int length) str
+	// strSub () {
+	// 	local value=$1
+	// 	local start=$2
+	// 	local length=$3
+	// 	tmpStrs[${tmpIndex}]="${value:${start}:${length}}"
+	// }
+	//
+	// # User script
+	//
+	// start=1
+	// tmpIndex=0
+	// strSub "abcdef" ${start} 3
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "StrToLower" -------- MARK: StrToLower
+
+func Example_strToLower() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = strToLower("ABC");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strToLower(str value) str
+	// strToLower () {
+	// 	local value=$1
+	// 	tmpStrs[${tmpIndex}]="${value,,}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strToLower "ABC"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "StrToUpper" -------- MARK: StrToUpper
+
+func Example_strToUpper() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = strToUpper("abc");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strToUpper(str value) str
+	// strToUpper () {
+	// 	local value=$1
+	// 	tmpStrs[${tmpIndex}]="${value^^}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strToUpper "abc"
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "StrTrim" -------- MARK: StrTrim
+
+func TestErrorStrTrimWithoutValue(t *testing.T) {
+	initTest()
+	err := transpileTest(`strTrim();`)
+	expected := fmt.Errorf("test.scri:1:1: Expected syntax: strTrim(str value)")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_strTrim() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = strTrim("  abc  ");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strTrim(str value) str
+	// strTrim () {
+	// 	local value=$1
+	// 	local trimmed="${value#"${value%%[![:space:]]*}"}"
+	// 	tmpStrs[${tmpIndex}]="${trimmed%"${trimmed##*[![:space:]]}"}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strTrim "  abc  "
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "StrTrimLeft" -------- MARK: StrTrimLeft
+
+func Example_strTrimLeft() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = strTrimLeft("  abc  ");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strTrimLeft(str value) str
+	// strTrimLeft () {
+	// 	local value=$1
+	// 	tmpStrs[${tmpIndex}]="${value#"${value%%[![:space:]]*}"}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strTrimLeft "  abc  "
+	// s="${tmpStrs[0]}"
+}
+
+// -------- Native function "StrTrimRight" -------- MARK: StrTrimRight
+
+func Example_strTrimRight() {
+	initTestForPrintMode()
+	transpileTest(`
+		str s = strTrimRight("  abc  ");
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # strTrimRight(str value) str
+	// strTrimRight () {
+	// 	local value=$1
+	// 	tmpStrs[${tmpIndex}]="${value%"${value##*[![:space:]]}"}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// strTrimRight "  abc  "
+	// s="${tmpStrs[0]}"
+}
@@ -0,0 +1,338 @@
+package bashAssembler
+
+import (
+	"fmt"
+	"testing"
+)
+
+// -------- Native function "cpuCount" -------- MARK: cpuCount
+
+func TestErrorCpuCountWithArg(t *testing.T) {
+	initTest()
+	err := transpileTest(`int count = cpuCount(1);`)
+	expected := fmt.Errorf("test.scri:1:13: Expected syntax: cpuCount()")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_cpuCount() {
+	initTestForPrintMode()
+	transpileTest(`int count = cpuCount();`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # cpuCount() int
+	// cpuCount () {
+	// 	tmpInts[${tmpIndex}]=$(grep -c '^processor' /proc/cpuinfo)
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// cpuCount
+	// count=${tmpInts[0]}
+}
+
+// -------- Native function "hostname" -------- MARK: hostname
+
+func Example_hostname() {
+	initTestForPrintMode()
+	transpileTest(`str host = hostname();`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # scrila_hostname() str
+	// scrila_hostname () {
+	// 	tmpStrs[${tmpIndex}]="${HOSTNAME}"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// scrila_hostname
+	// host="${tmpStrs[0]}"
+}
+
+// -------- Native function "isRoot" -------- MARK: isRoot
+
+func Example_isRoot() {
+	initTestForPrintMode()
+	transpileTest(`
+	if (isRoot() == false) {
+		printLn("Must run as root");
+		exit(1);
+	}
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # isRoot() bool
+	// isRoot () {
+	// 	if [[ ${EUID} -eq 0 ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// isRoot
+	// if [[ "${tmpBools[0]}" == "false" ]]
+	// then
+	// 	echo "Must run as root"
+	// 	exit 1
+	// fi
+}
+
+// -------- Native function "killProcess" -------- MARK: killProcess
+
+func TestErrorKillProcessWithStrPid(t *testing.T) {
+	initTest()
+	err := transpileTest(`killProcess("1", "TERM");`)
+	expected := fmt.Errorf("test.scri:1:1: killProcess() - Parameter pid must be an int or a variable of type int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorKillProcessWithInvalidSignal(t *testing.T) {
+	initTest()
+	err := transpileTest(`killProcess(1, "FOO");`)
+	expected := fmt.Errorf("test.scri:1:1: killProcess() - Invalid signal 'FOO'. Expected one of HUP, INT, QUIT, TERM, USR1, USR2, KILL, STOP, CONT")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_killProcess() {
+	initTestForPrintMode()
+	transpileTest(`killProcess(42, "TERM");`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # nativeError(str message) void
+	// nativeError () {
+	// 	local message=$1
+	// 	echo "ScriLa error: ${message}" >&2
+	// 	exit 1
+	// }
+	//
+	// # killProcess(int pid, str signal) void
+	// killProcess () {
+	// 	local pid=$1
+	// 	local signal=$2
+	// 	if [[ " HUP INT QUIT TERM USR1 USR2 KILL STOP CONT " != *" ${signal} "* ]]
+	// 	then
+	// 		nativeError "killProcess() - Invalid signal '${signal}'. Expected one of HUP, INT, QUIT, TERM, USR1, USR2, KILL, STOP, CONT"
+	// 	fi
+	// 	kill -s "${signal}" "${pid}" 2> /dev/null || nativeError "killProcess() - Cannot send signal '${signal}' to process ${pid}"
+	// }
+	//
+	// # User script
+	//
+	// killProcess 42 "TERM"
+}
+
+func TestKillProcess(t *testing.T) {
+	output := runBashTest(t, `
+		func handler() void {
+			printLn("USR1");
+		}
+		onSignal("USR1", handler);
+		killProcess(pid(), "USR1");
+	`)
+	expected := "USR1"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "osName" -------- MARK: osName
+
+func Example_osName() {
+	initTestForPrintMode()
+	transpileTest(`str os = osName();`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # osName() str
+	// osName () {
+	// 	if [[ -r /etc/os-release ]]
+	// 	then
+	// 		tmpStrs[${tmpIndex}]="$(. /etc/os-release && printf '%s' "${NAME}")"
+	// 	else
+	// 		tmpStrs[${tmpIndex}]="$(uname -s)"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// osName
+	// os="${tmpStrs[0]}"
+}
+
+// -------- Native function "pid" -------- MARK: pid
+
+func TestErrorPidWithArg(t *testing.T) {
+	initTest()
+	err := transpileTest(`int p = pid(1);`)
+	expected := fmt.Errorf("test.scri:1:9: Expected syntax: pid()")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_pid() {
+	initTestForPrintMode()
+	transpileTest(`int p = pid();`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # pid() int
+	// pid () {
+	// 	tmpInts[${tmpIndex}]=$$
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// pid
+	// p=${tmpInts[0]}
+}
+
+func TestPid(t *testing.T) {
+	output := runBashTest(t, `
+		printLn(pid() == strToInt(exec("echo $$")));
+	`)
+	expected := "true"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "ppid" -------- MARK: ppid
+
+func Example_ppid() {
+	initTestForPrintMode()
+	transpileTest(`int p = ppid();`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # ppid() int
+	// ppid () {
+	// 	tmpInts[${tmpIndex}]=${PPID}
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// ppid
+	// p=${tmpInts[0]}
+}
+
+// -------- Native function "processRunning" -------- MARK: processRunning
+
+func TestErrorProcessRunningWithStr(t *testing.T) {
+	initTest()
+	err := transpileTest(`bool running = processRunning("1");`)
+	expected := fmt.Errorf("test.scri:1:16: processRunning() - Parameter pid must be an int or a variable of type int. Got 'StrLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_processRunning() {
+	initTestForPrintMode()
+	transpileTest(`bool running = processRunning(42);`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # processRunning(int pid) bool
+	// processRunning () {
+	// 	local pid=$1
+	// 	if [[ -d "/proc/${pid}" ]]
+	// 	then
+	// 		tmpBools[${tmpIndex}]="true"
+	// 	else
+	// 		tmpBools[${tmpIndex}]="false"
+	// 	fi
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// processRunning 42
+	// running="${tmpBools[0]}"
+}
+
+func TestProcessRunning(t *testing.T) {
+	output := runBashTest(t, `
+		printLn(processRunning(pid()), processRunning(999999));
+	`)
+	expected := "true false"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+// -------- Native function "whoami" -------- MARK: whoami
+
+func Example_whoami() {
+	initTestForPrintMode()
+	transpileTest(`str user = whoami();`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # scrila_whoami() str
+	// scrila_whoami () {
+	// 	tmpStrs[${tmpIndex}]="$(id -un)"
+	// }
+	//
+	// # User script
+	//
+	// tmpIndex=0
+	// scrila_whoami
+	// user="${tmpStrs[0]}"
+}
+
+func TestWhoami(t *testing.T) {
+	output := runBashTest(t, `
+		printLn(whoami() == exec("id -un"));
+	`)
+	expected := "true"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
@@ -2,6 +2,7 @@ package bashAssembler
 
 import (
 	"fmt"
+	"os/exec"
 	"strings"
 	"testing"
 )
@@ -43,6 +44,7 @@ func Example_exec() {
 	// exec () {
 	// 	local command=$1
 	// 	tmpStrs[${tmpIndex}]=$(eval ${command})
+	// 	execExitCode=$?
 	// }
 	//
 	// # User script
@@ -210,6 +212,26 @@ func Example_print() {
 	// echo "$((${tmpInts[0]} + 2))"
 }
 
+// -------- Native function "PrintErr" -------- MARK: PrintErr
+
+func Example_printErr() {
+	initTestForPrintMode()
+	transpileTest(`
+		int i = 42;
+		printErr("Error code:", i);
+		printErrLn("Error code:", i);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// i=42
+	// echo -n "Error code: ${i}" >&2
+	// echo "Error code: ${i}" >&2
+}
+
 // -------- Native function "Sleep" -------- MARK: Sleep
 
 func TestErrorSleepFuncCallWithWrongParamVarType(t *testing.T) {
@@ -531,7 +553,7 @@ func Example_strSplit() {
 	//
 	// tmpIndex=0
 	// strSplit "a,b,c,d" ","
-	// strs=${tmpStrs[@]}
+	// strs=("${tmpStrs[@]}")
 }
 
 // -------- Native function "StrStartsWith" -------- MARK: StrStartsWith
@@ -941,3 +963,127 @@ func TestErrorFuncInFunc(t *testing.T) {
 		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
 	}
 }
+
+func Example_funcDeclarationWithArrayParam() {
+	initTestForPrintMode()
+	transpileTest(`
+	func show(str prefix, str[] items) void {
+		for (str item in items) {
+			printLn(prefix + item);
+		}
+	}
+	str[] names = ["a b", "c"];
+	show("- ", names);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// # show(str prefix, str[] items) void
+	// show () {
+	// 	local prefix=$1
+	// 	local items=("${@:2}")
+	// 	for item in "${items[@]}"
+	// 	do
+	// 		echo "${prefix}${item}"
+	// 	done
+	// }
+	//
+	// names=("a b" "c")
+	// show "- " "${names[@]}"
+}
+
+// -------- Entry point -------- MARK: Entry point
+
+func TestErrorMainWithoutArgs(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func main() int {
+			return 0;
+		}`)
+	expected := fmt.Errorf("test.scri:2:3: The entry point must be declared as 'func main(str[] args) int'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorMainWithVoidReturnType(t *testing.T) {
+	initTest()
+	err := transpileTest(`
+		func main(str[] args) void {
+			printLn("Hello");
+		}`)
+	expected := fmt.Errorf("test.scri:2:3: The entry point must be declared as 'func main(str[] args) int'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_main() {
+	initTestForPrintMode()
+	transpileTest(`
+	func main(str[] args) int {
+		for (str arg in args) {
+			printLn(arg);
+		}
+		return 0;
+	}
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # User script
+	//
+	// # main(str[] args) int
+	// main () {
+	// 	local args=("${@:1}")
+	// 	for arg in "${args[@]}"
+	// 	do
+	// 		echo "${arg}"
+	// 	done
+	// 	tmpInts[${tmpIndex}]=0
+	// 	return
+	// }
+	//
+	// # Call the entry point with the arguments of the script
+	// tmpIndex=0
+	// main "$@"
+	// exit "${tmpInts[${tmpIndex}]}"
+}
+
+func TestMainExitCode(t *testing.T) {
+	output, err := execBashTest(t, `
+		printLn("Before main");
+		func main(str[] args) int {
+			printLn("First argument: [" + args[0] + "]");
+			return 3;
+		}
+	`, "")
+	expected := "Before main\nFirst argument: []"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+	exitErr, ok := err.(*exec.ExitError)
+	if !ok || exitErr.ExitCode() != 3 {
+		t.Errorf("Expected exit code 3, Got: %v", err)
+	}
+}
+
+func TestMainWithoutReturn(t *testing.T) {
+	output := runBashTest(t, `
+		func main(str[] args) int {
+			int n = strLen("abc");
+			if (n > 5) {
+				return 1;
+			}
+			printLn(n);
+		}
+	`)
+	expected := "3"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"ScriLa/cmd/scrila/config"
 	"ScriLa/cmd/scrila/parser"
 	"fmt"
+	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -46,6 +48,63 @@ func transpileTest(code string) error {
 	return err
 }
 
+// Transpiles the given code and assembles only the Bash completion for the given command
+func transpileCompletionTest(code string, command string) error {
+	transpiler := bashTranspiler.NewTranspiler()
+	env := bashTranspiler.NewEnvironment(nil, transpiler)
+	scrilaProgram, err := parser.NewParser().ProduceAST(code)
+	if err != nil {
+		return err
+	}
+	if _, err = transpiler.Transpile(scrilaProgram, env); err != nil {
+		return err
+	}
+	completionProgram, err := transpiler.TranspileCompletion(command)
+	if err != nil {
+		return err
+	}
+	return testAssembler.AssembleCompletion(completionProgram)
+}
+
+// Transpiles the given code into a temporary Bash script, executes it and returns its output
+func runBashTest(t *testing.T, code string) string {
+	return runBashTestWithStdin(t, code, "")
+}
+
+func runBashTestWithStdin(t *testing.T, code string, stdin string) string {
+	output, err := execBashTest(t, code, stdin)
+	if err != nil {
+		t.Fatalf("%s\n%s", err, output)
+	}
+	return output
+}
+
+// Like runBashTest but expects that the script exits with an error
+func runFailingBashTest(t *testing.T, code string) string {
+	output, err := execBashTest(t, code, "")
+	if err == nil {
+		t.Fatalf("Expected the script to fail. Got output: %s", output)
+	}
+	return output
+}
+
+func execBashTest(t *testing.T, code string, stdin string, args ...string) (string, error) {
+	initTest()
+	testAssembler.testMode = false
+	config.Filename = filepath.Join(t.TempDir(), "test.scri")
+	defer func() { config.Filename = "test.scri" }()
+
+	if err := transpileTest(code); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("bash", append([]string{config.Filename + ".sh"}, args...)...)
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+	output, err := cmd.CombinedOutput()
+	return strings.TrimSuffix(string(output), "\n"), err
+}
+
 func TestErrorLexerUnrecognizedChar(t *testing.T) {
 	initTest()
 	err := transpileTest(`~`)
@@ -110,6 +169,250 @@ func TestErrorBinaryExprWithUnsupportedCombination(t *testing.T) {
 	}
 }
 
+func Example_floatVar() {
+	initTestForPrintMode()
+	transpileTest(`
+		float f = 3.14;
+		f = 1.0;
+		# Int is promoted to float
+		float g = f * 2;
+		float h = (1 + 2) / (f + 0.5);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # floatCalc(str lhs, str operator, str rhs) str
+	// floatCalc () {
+	// 	local lhs=$1
+	// 	local operator=$2
+	// 	local rhs=$3
+	// 	LC_ALL=C awk -v lhs="${lhs}" -v operator="${operator}" -v rhs="${rhs}" 'BEGIN {
+	// 		lhs += 0; rhs += 0
+	// 		if (operator == "<") exit !(lhs < rhs)
+	// 		if (operator == ">") exit !(lhs > rhs)
+	// 		if (operator == "<=") exit !(lhs <= rhs)
+	// 		if (operator == ">=") exit !(lhs >= rhs)
+	// 		if (operator == "==") exit !(lhs == rhs)
+	// 		if (operator == "!=") exit !(lhs != rhs)
+	// 		if (operator == "floor") { result = int(lhs); if (result > lhs) result--; printf "%d\n", result; exit }
+	// 		if (operator == "ceil") { result = int(lhs); if (result < lhs) result++; printf "%d\n", result; exit }
+	// 		if (operator == "round") { result = lhs < 0 ? -int(0.5 - lhs) : int(lhs + 0.5); printf "%d\n", result; exit }
+	// 		if (operator == "+") result = lhs + rhs
+	// 		if (operator == "-") result = lhs - rhs
+	// 		if (operator == "*") result = lhs * rhs
+	// 		if (operator == "/") result = lhs / rhs
+	// 		result = sprintf("%.15g", result)
+	// 		if (result !~ /[.eEnN]/) result = result ".0"
+	// 		print result
+	// 	}'
+	// }
+	//
+	// # User script
+	//
+	// f=3.14
+	// f=1.0
+	// # Int is promoted to float
+	// g=$(floatCalc "${f}" "*" "2")
+	// h=$(floatCalc "$((1 + 2))" "/" "$(floatCalc "${f}" "+" "0.5")")
+}
+
+func Example_floatComparison() {
+	initTestForPrintMode()
+	transpileTest(`
+		float f = 2.5;
+		bool b = f >= 2;
+		if (f < 3.0 && b) {
+			printLn(f);
+		}
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # floatCalc(str lhs, str operator, str rhs) str
+	// floatCalc () {
+	// 	local lhs=$1
+	// 	local operator=$2
+	// 	local rhs=$3
+	// 	LC_ALL=C awk -v lhs="${lhs}" -v operator="${operator}" -v rhs="${rhs}" 'BEGIN {
+	// 		lhs += 0; rhs += 0
+	// 		if (operator == "<") exit !(lhs < rhs)
+	// 		if (operator == ">") exit !(lhs > rhs)
+	// 		if (operator == "<=") exit !(lhs <= rhs)
+	// 		if (operator == ">=") exit !(lhs >= rhs)
+	// 		if (operator == "==") exit !(lhs == rhs)
+	// 		if (operator == "!=") exit !(lhs != rhs)
+	// 		if (operator == "floor") { result = int(lhs); if (result > lhs) result--; printf "%d\n", result; exit }
+	// 		if (operator == "ceil") { result = int(lhs); if (result < lhs) result++; printf "%d\n", result; exit }
+	// 		if (operator == "round") { result = lhs < 0 ? -int(0.5 - lhs) : int(lhs + 0.5); printf "%d\n", result; exit }
+	// 		if (operator == "+") result = lhs + rhs
+	// 		if (operator == "-") result = lhs - rhs
+	// 		if (operator == "*") result = lhs * rhs
+	// 		if (operator == "/") result = lhs / rhs
+	// 		result = sprintf("%.15g", result)
+	// 		if (result !~ /[.eEnN]/) result = result ".0"
+	// 		print result
+	// 	}'
+	// }
+	//
+	// # User script
+	//
+	// f=2.5
+	// if floatCalc "${f}" ">=" "2"
+	// then
+	// 	tmpBools[0]="true"
+	// else
+	// 	tmpBools[0]="false"
+	// fi
+	// b="${tmpBools[0]}"
+	// if floatCalc "${f}" "<" "3.0" && [[ "${b}" == "true" ]]
+	// then
+	// 	echo "${f}"
+	// fi
+}
+
+func TestErrorUnsupportedFloatOperation(t *testing.T) {
+	initTest()
+	err := transpileTest(`float f = 1.5 && 2;`)
+	expected := fmt.Errorf("test.scri:1:15: Binary float expression with unsupported operator '&&'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorAssignFloatToInt(t *testing.T) {
+	initTest()
+	err := transpileTest(`int i = 1 + 0.5;`)
+	expected := fmt.Errorf("test.scri:1:11: Cannot assign a value of type 'FloatLiteral' to a var of type 'IntLiteral'")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func Example_intPromotedToFloat() {
+	initTestForPrintMode()
+	transpileTest(`
+		func half(float value) float {
+			return value / 2;
+		}
+		int i = 3;
+		float f = 2;
+		f = i;
+		float[] floats = [1.5];
+		floats[] = 4;
+		f = half(i);
+	`)
+
+	// Output:
+	// #!/bin/bash
+	//
+	// # Native function implementations
+	//
+	// # floatCalc(str lhs, str operator, str rhs) str
+	// floatCalc () {
+	// 	local lhs=$1
+	// 	local operator=$2
+	// 	local rhs=$3
+	// 	LC_ALL=C awk -v lhs="${lhs}" -v operator="${operator}" -v rhs="${rhs}" 'BEGIN {
+	// 		lhs += 0; rhs += 0
+	// 		if (operator == "<") exit !(lhs < rhs)
+	// 		if (operator == ">") exit !(lhs > rhs)
+	// 		if (operator == "<=") exit !(lhs <= rhs)
+	// 		if (operator == ">=") exit !(lhs >= rhs)
+	// 		if (operator == "==") exit !(lhs == rhs)
+	// 		if (operator == "!=") exit !(lhs != rhs)
+	// 		if (operator == "floor") { result = int(lhs); if (result > lhs) result--; printf "%d\n", result; exit }
+	// 		if (operator == "ceil") { result = int(lhs); if (result < lhs) result++; printf "%d\n", result; exit }
+	// 		if (operator == "round") { result = lhs < 0 ? -int(0.5 - lhs) : int(lhs + 0.5); printf "%d\n", result; exit }
+	// 		if (operator == "+") result = lhs + rhs
+	// 		if (operator == "-") result = lhs - rhs
+	// 		if (operator == "*") result = lhs * rhs
+	// 		if (operator == "/") result = lhs / rhs
+	// 		result = sprintf("%.15g", result)
+	// 		if (result !~ /[.eEnN]/) result = result ".0"
+	// 		print result
+	// 	}'
+	// }
+	//
+	// # User script
+	//
+	// # half(float value) float
+	// half () {
+	// 	local value=$1
+	// 	tmpFloats[${tmpIndex}]=$(floatCalc "${value}" "/" "2")
+	// 	return
+	// }
+	//
+	// i=3
+	// f=2.0
+	// f=$(floatCalc "${i}" "+" "0.0")
+	// floats=(1.5)
+	// floats+=(4.0)
+	// tmpIndex=0
+	// half $(floatCalc "${i}" "+" "0.0")
+	// f=${tmpFloats[0]}
+}
+
+func TestIntPromotedToFloat(t *testing.T) {
+	output := runBashTest(t, `
+		func half(float value) float {
+			return value / 2;
+		}
+		func one() float {
+			return 1;
+		}
+		int i = 3;
+		float f = i;
+		printLn(f, half(i), one());
+	`)
+	expected := "3.0 1.5 1.0"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
+func TestErrorIntDivisionByZero(t *testing.T) {
+	initTest()
+	err := transpileTest(`int i = 1 / 0;`)
+	expected := fmt.Errorf("test.scri:1:11: Division by zero")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestErrorFloatDivisionByZero(t *testing.T) {
+	initTest()
+	err := transpileTest(`float f = 1.5 / 0.0;`)
+	expected := fmt.Errorf("test.scri:1:15: Division by zero")
+	if err.Error() != expected.Error() {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, err)
+	}
+}
+
+func TestDivisionGuardedByCondition(t *testing.T) {
+	output := runBashTest(t, `
+		int x = 12;
+		int y = 0;
+		if (y != 0 && x / y > 1) {
+			printLn("not reached");
+		}
+		int i = 1;
+		while (x / i > 2) {
+			i = i + 1;
+		}
+		printLn(i);
+	`)
+	expected := "5"
+	if output != expected {
+		t.Errorf("Expected: \"%s\", Got: \"%s\"", expected, output)
+	}
+}
+
 func TestErrorIntDeclarationWithMissingSemicolon(t *testing.T) {
 	initTest()
 	err := transpileTest(`int i = 42`)
@@ -510,11 +813,11 @@ func ExampleArray() {
 	// # array() int[]
 	// array () {
 	// 	local tmpArray=(41 42)
-	// 	tmpInts=${tmpArray[@]}
+	// 	tmpInts=("${tmpArray[@]}")
 	// 	return
 	// }
 	//
 	// tmpIndex=0
 	// array
-	// result=${tmpInts[@]}
+	// result=("${tmpInts[@]}")
 }
@@ -42,6 +42,10 @@ func (self *Assembler) evalAssignmentExpr(assignment bashAst.IAssignmentExpr) er
 	if err != nil {
 		return err
 	}
+	if assignment.GetValue().GetKind() == bashAst.VarLiteralNode && isArrayType(bashAst.StmtToVarLiteral(assignment.GetValue()).GetDataType()) {
+		// Copy the array element by element e.g.: values=("${tmpStrs[@]}")
+		bash = fmt.Sprintf("(%s)", bash)
+	}
 
 	format := "%s=%s"
 	if assignment.IsDeclaration() && self.isFuncContext {
@@ -71,6 +75,10 @@ func (self *Assembler) evalCallExpr(callExpr bashAst.ICallExpr) error {
 		if err != nil {
 			return err
 		}
+		if arg.GetKind() == bashAst.ArrayLiteralNode {
+			// Arrays passed as arguments are not wrapped in parentheses
+			bash = bash[1 : len(bash)-1]
+		}
 		self.writeToFile(fmt.Sprintf(" %s", bash))
 	}
 	self.writeLnToFile("")
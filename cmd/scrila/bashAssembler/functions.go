@@ -9,10 +9,12 @@ type nativeScrilaFunc func(args []bashAst.IStatement) error
 
 func (self *Assembler) registerNativeScrilaFuncs() {
 	self.nativeScrilaFuncs = map[string]nativeScrilaFunc{
-		"exit":    self.nativeFnExit,
-		"print":   self.nativeFnPrint,
-		"printLn": self.nativeFnPrintLn,
-		"sleep":   self.nativeFnSleep,
+		"exit":       self.nativeFnExit,
+		"print":      self.nativeFnPrint,
+		"printErr":   self.nativeFnPrintErr,
+		"printErrLn": self.nativeFnPrintErrLn,
+		"printLn":    self.nativeFnPrintLn,
+		"sleep":      self.nativeFnSleep,
 	}
 }
 
@@ -45,6 +47,26 @@ func (self *Assembler) nativeFnPrintLn(args []bashAst.IStatement) error {
 	return nil
 }
 
+func (self *Assembler) nativeFnPrintErr(args []bashAst.IStatement) error {
+	self.writeWithTabsToFile("echo -n ")
+	argStr, err := printArgsToBashStr(args)
+	if err != nil {
+		return err
+	}
+	self.writeLnToFile(strToBashStr(argStr) + " >&2")
+	return nil
+}
+
+func (self *Assembler) nativeFnPrintErrLn(args []bashAst.IStatement) error {
+	self.writeWithTabsToFile("echo ")
+	argStr, err := printArgsToBashStr(args)
+	if err != nil {
+		return err
+	}
+	self.writeLnToFile(strToBashStr(argStr) + " >&2")
+	return nil
+}
+
 func (self *Assembler) nativeFnSleep(args []bashAst.IStatement) error {
 	bash, err := stmtToBashStr(args[0])
 	if err != nil {
@@ -69,6 +91,40 @@ func printArgsToBashStr(args []bashAst.IStatement) (string, error) {
 	return argStr, nil
 }
 
+// Write the local variables for the given function parameters.
+// An array parameter takes all arguments that are not taken by the other parameters.
+// Parameters following the array are read from the end of the argument list.
+func (self *Assembler) writeFuncParams(params []bashAst.IFuncParameter) error {
+	arrayIndex := -1
+	for i, param := range params {
+		if !isArrayType(param.GetType()) {
+			continue
+		}
+		if arrayIndex != -1 {
+			return fmt.Errorf("writeFuncParams(): Only one array parameter is supported")
+		}
+		arrayIndex = i
+	}
+
+	for i, param := range params {
+		switch {
+		case arrayIndex == -1 || i < arrayIndex:
+			// e.g.: local value=$1
+			self.writeLnWithTabsToFile(fmt.Sprintf("local %s=$%d", param.GetName(), i+1))
+		case i == arrayIndex && i == len(params)-1:
+			// e.g.: local values=("${@:2}")
+			self.writeLnWithTabsToFile(fmt.Sprintf("local %s=(\"${@:%d}\")", param.GetName(), i+1))
+		case i == arrayIndex:
+			// e.g.: local values=("${@:1:$#-1}")
+			self.writeLnWithTabsToFile(fmt.Sprintf("local %s=(\"${@:%d:$#-%d}\")", param.GetName(), i+1, len(params)-1))
+		default:
+			// e.g.: local value=${@: -1:1}
+			self.writeLnWithTabsToFile(fmt.Sprintf("local %s=${@: -%d:1}", param.GetName(), len(params)-i))
+		}
+	}
+	return nil
+}
+
 // Generate function signature for documentation header
 func (self *Assembler) getFuncSignature(funcDecl bashAst.IFuncDeclaration) (string, error) {
 	params := ""
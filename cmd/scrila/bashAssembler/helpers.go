@@ -3,6 +3,10 @@ package bashAssembler
 import (
 	"ScriLa/cmd/scrila/bashAst"
 	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/slices"
 )
 
 func (self *Assembler) assembleBody(stmts []bashAst.IStatement) error {
@@ -34,7 +38,7 @@ func stmtToBashConditionStr(stmt bashAst.IStatement) (string, error) {
 	switch stmt.GetKind() {
 	case bashAst.BinaryCompExprNode, bashAst.BinaryOpExprNode:
 		return bash, nil
-	case bashAst.BoolLiteralNode, bashAst.VarLiteralNode:
+	case bashAst.BoolLiteralNode, bashAst.MemberExprNode, bashAst.VarLiteralNode:
 		return strToBashBoolComparison(bash), nil
 	default:
 		return "", fmt.Errorf("stmtToBashConditionStr(): Kind '%s' is not implemented", stmt.GetKind())
@@ -54,11 +58,12 @@ func stmtToRhsBashStr(stmt bashAst.IStatement) (string, error) {
 		case bashAst.StrLiteralNode:
 			return strToBashStr(bash), nil
 		}
-	case bashAst.BoolLiteralNode, bashAst.StrLiteralNode:
+	case bashAst.BoolLiteralNode, bashAst.PipelineNode, bashAst.StrLiteralNode:
 		return strToBashStr(bash), nil
 	case bashAst.VarLiteralNode:
-		switch varType := bashAst.StmtToVarLiteral(stmt).GetDataType(); varType {
-		case bashAst.BoolLiteralNode, bashAst.StrLiteralNode:
+		varType := bashAst.StmtToVarLiteral(stmt).GetDataType()
+		if varType == bashAst.BoolLiteralNode || varType == bashAst.StrLiteralNode || isArrayType(varType) {
+			// Quote arrays to keep elements with whitespaces e.g.: "${var[@]}"
 			return strToBashStr(bash), nil
 		}
 	}
@@ -83,6 +88,16 @@ func stmtToBashStr(stmt bashAst.IStatement) (string, error) {
 	case bashAst.MemberExprNode:
 		// e.g.: arr[42]
 		return memberExprToBashStr(bashAst.StmtToMemberExpr(stmt))
+	case bashAst.PipelineNode:
+		// e.g.: $( (set -o pipefail; eval "ps aux" | eval "grep nginx") 2>&1)
+		bash, err := pipelineToBashStr(bashAst.StmtToPipeline(stmt))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("$( %s)", bash), nil
+	case bashAst.FloatLiteralNode:
+		// e.g.: 3.14
+		return floatToBashStr(bashAst.StmtToFloatLiteral(stmt).GetValue()), nil
 	case bashAst.IntLiteralNode:
 		// e.g.: 42
 		return fmt.Sprintf("%d", bashAst.StmtToIntLiteral(stmt).GetValue()), nil
@@ -91,10 +106,10 @@ func stmtToBashStr(stmt bashAst.IStatement) (string, error) {
 		return bashAst.StmtToStrLiteral(stmt).GetValue(), nil
 	case bashAst.VarLiteralNode:
 		switch varType := bashAst.StmtToVarLiteral(stmt).GetDataType(); varType {
-		case bashAst.ArrayLiteralNode, bashAst.BoolArrayNode, bashAst.IntArrayNode, bashAst.StrArrayNode:
+		case bashAst.ArrayLiteralNode, bashAst.BoolArrayNode, bashAst.ExecResultNode, bashAst.FloatArrayNode, bashAst.IntArrayNode, bashAst.JobArrayNode, bashAst.StrArrayNode:
 			// e.g.: "${var[@]}"
 			return strToBashVar(fmt.Sprintf("%s[@]", bashAst.StmtToVarLiteral(stmt).GetValue())), nil
-		case bashAst.BoolLiteralNode, bashAst.IntLiteralNode, bashAst.StrLiteralNode:
+		case bashAst.BoolLiteralNode, bashAst.FloatLiteralNode, bashAst.IntLiteralNode, bashAst.JobNode, bashAst.StrLiteralNode:
 			// e.g.: ${var}
 			return strToBashVar(bashAst.StmtToVarLiteral(stmt).GetValue()), nil
 		default:
@@ -137,6 +152,9 @@ func binCompToBashStr(binOp bashAst.IBinaryOpExpr) (string, error) {
 	case bashAst.IntLiteralNode:
 		opMapping := map[string]string{">": "-gt", "<": "-lt", ">=": "-ge", "<=": "-le", "==": "-eq", "!=": "-ne"}
 		return fmt.Sprintf("[[ %s %s %s ]]", lhs, opMapping[binOp.GetOperator()], rhs), nil
+	case bashAst.FloatLiteralNode:
+		// The exit code of floatCalc is the result of the comparison
+		return fmt.Sprintf("floatCalc %s \"%s\" %s", strToBashStr(lhs), binOp.GetOperator(), strToBashStr(rhs)), nil
 	default:
 		return "", fmt.Errorf("binCompToBashStr(): Kind '%s' is not implemented", binOp.GetDataType())
 	}
@@ -163,6 +181,8 @@ func binOpToBashStr(binOp bashAst.IBinaryOpExpr) (string, error) {
 			rhs = strToBashBoolComparison(strToBashStr(rhs))
 		}
 		return fmt.Sprintf("%s %s %s", lhs, binOp.GetOperator(), rhs), nil
+	case bashAst.FloatLiteralNode:
+		return fmt.Sprintf("$(floatCalc %s \"%s\" %s)", strToBashStr(lhs), binOp.GetOperator(), strToBashStr(rhs)), nil
 	case bashAst.IntLiteralNode:
 		return fmt.Sprintf("$((%s %s %s))", lhs, binOp.GetOperator(), rhs), nil
 	case bashAst.StrLiteralNode:
@@ -210,19 +230,65 @@ func boolToBashStr(value bool) string {
 	return "false"
 }
 
+// Returns the float as string that always contains a decimal point e.g. "1.0"
+func floatToBashStr(value float64) string {
+	str := strconv.FormatFloat(value, 'f', -1, 64)
+	if !strings.Contains(str, ".") {
+		str += ".0"
+	}
+	return str
+}
+
+// Returns the pipeline in a subshell so that "pipefail" does not change the exit codes of the rest of the script
+func pipelineToBashStr(pipeline bashAst.IPipeline) (string, error) {
+	commands := []string{}
+	for _, command := range pipeline.GetCommands() {
+		bash, err := stmtToRhsBashStr(command)
+		if err != nil {
+			return "", err
+		}
+		commands = append(commands, "eval "+bash)
+	}
+	bash := fmt.Sprintf("(set -o pipefail; %s)", strings.Join(commands, " | "))
+
+	for _, redirection := range pipeline.GetRedirections() {
+		if redirection.GetTarget() == nil {
+			bash += " " + redirection.GetOperator()
+			continue
+		}
+		target, err := stmtToRhsBashStr(redirection.GetTarget())
+		if err != nil {
+			return "", err
+		}
+		bash += fmt.Sprintf(" %s %s", redirection.GetOperator(), target)
+	}
+	return bash, nil
+}
+
 // Return the variable name as Bash variable
 func strToBashVar(value string) string {
 	return fmt.Sprintf("${%s}", value)
 }
 
+func isArrayType(nodeType bashAst.NodeType) bool {
+	return slices.Contains([]bashAst.NodeType{bashAst.ArrayLiteralNode, bashAst.BoolArrayNode, bashAst.ExecResultNode, bashAst.FloatArrayNode, bashAst.IntArrayNode, bashAst.JobArrayNode, bashAst.StrArrayNode}, nodeType)
+}
+
 var nodeTypeToVarTypeKeywordMapping = map[bashAst.NodeType]string{
-	bashAst.BoolArrayNode:   "bool[]",
-	bashAst.BoolLiteralNode: "bool",
-	bashAst.IntArrayNode:    "int[]",
-	bashAst.IntLiteralNode:  "int",
-	bashAst.StrArrayNode:    "str[]",
-	bashAst.StrLiteralNode:  "str",
-	bashAst.VoidNode:        "void",
+	bashAst.ArrayLiteralNode: "array",
+	bashAst.BoolArrayNode:    "bool[]",
+	bashAst.BoolLiteralNode:  "bool",
+	bashAst.ExecResultNode:   "execResult",
+	bashAst.FloatArrayNode:   "float[]",
+	bashAst.FloatLiteralNode: "float",
+	bashAst.FuncRefNode:      "func",
+	bashAst.IntArrayNode:     "int[]",
+	bashAst.IntLiteralNode:   "int",
+	bashAst.JobArrayNode:     "job[]",
+	bashAst.JobNode:          "job",
+	bashAst.StrArrayNode:     "str[]",
+	bashAst.StrLiteralNode:   "str",
+	bashAst.VoidNode:         "void",
 }
 
 // Returns the ScriLa variable type keyword for the given Bash NodeType
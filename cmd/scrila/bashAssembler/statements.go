@@ -52,8 +52,8 @@ func (self *Assembler) evalFuncDeclaration(funcDecl bashAst.IFuncDeclaration) er
 	self.incTabs()
 
 	// Setup parameters
-	for i, param := range funcDecl.GetParams() {
-		self.writeLnWithTabsToFile(fmt.Sprintf("local %s=$%d", param.GetName(), i+1))
+	if err = self.writeFuncParams(funcDecl.GetParams()); err != nil {
+		return err
 	}
 
 	// Assemble body line by line
@@ -120,6 +120,16 @@ func (self *Assembler) evalIfStmtElse(ifStmt bashAst.IIfStmt) error {
 	return nil
 }
 
+func (self *Assembler) evalPipeline(pipeline bashAst.IPipeline) error {
+	// e.g.: (set -o pipefail; eval "ps aux" | eval "grep nginx") > "out.txt"
+	bash, err := pipelineToBashStr(pipeline)
+	if err != nil {
+		return err
+	}
+	self.writeLnWithTabsToFile(bash)
+	return nil
+}
+
 func (self *Assembler) evalProgram(program bashAst.IProgram) error {
 	var err error
 
@@ -142,6 +152,34 @@ func (self *Assembler) evalProgram(program bashAst.IProgram) error {
 	return nil
 }
 
+func (self *Assembler) evalWhileReadStmt(whileReadStmt bashAst.IWhileReadStmt) error {
+	source, err := stmtToRhsBashStr(whileReadStmt.GetSource())
+	if err != nil {
+		return err
+	}
+	// The lines are read from fd 3 so that commands in the body reading stdin do not consume them.
+	// The second condition handles a last line without trailing newline.
+	index := whileReadStmt.GetIndex().GetValue()
+	self.writeLnWithTabsToFile(fmt.Sprintf("while IFS= read -r -u 3 %s || [[ -n ${%s} ]]", index, index))
+	self.writeLnWithTabsToFile("do")
+	self.incTabs()
+
+	// Assemble body line by line
+	if err = self.assembleBody(whileReadStmt.GetBody()); err != nil {
+		return err
+	}
+	self.decTabs()
+
+	if whileReadStmt.IsCommand() {
+		// e.g.: done 3< <(eval "ls -l")
+		self.writeLnWithTabsToFile(fmt.Sprintf("done 3< <(eval %s)", source))
+	} else {
+		// e.g.: done 3< "file.txt"
+		self.writeLnWithTabsToFile(fmt.Sprintf("done 3< %s", source))
+	}
+	return nil
+}
+
 func (self *Assembler) evalWhileStmt(whileStmt bashAst.IWhileStmt) error {
 	bash, err := stmtToBashConditionStr(whileStmt.GetCondition())
 	if err != nil {
@@ -8,8 +8,11 @@ const (
 	CommentNode         NodeType = "CommentStmt"
 	FuncDeclarationNode NodeType = "FuncDeclarationStmt"
 	IfStmtNode          NodeType = "IfStmt"
+	PipelineNode        NodeType = "PipelineStmt"
 	ProgramNode         NodeType = "ProgramStmt"
+	RedirectionNode     NodeType = "RedirectionStmt"
 	WhileStmtNode       NodeType = "WhileStmt"
+	WhileReadStmtNode   NodeType = "WhileReadStmt"
 	ForStmtNode         NodeType = "ForStmt"
 
 	// Expressions
@@ -27,8 +30,14 @@ const (
 	ArrayLiteralNode NodeType = "Array"
 	BoolArrayNode    NodeType = "BoolArray"
 	BoolLiteralNode  NodeType = "BoolLiteral"
+	ExecResultNode   NodeType = "ExecResult"
+	FloatArrayNode   NodeType = "FloatArray"
+	FloatLiteralNode NodeType = "FloatLiteral"
+	FuncRefNode      NodeType = "FuncRef"
 	IntArrayNode     NodeType = "IntArray"
 	IntLiteralNode   NodeType = "IntLiteral"
+	JobArrayNode     NodeType = "JobArray"
+	JobNode          NodeType = "Job"
 	StrArrayNode     NodeType = "StrArray"
 	StrLiteralNode   NodeType = "StrLiteral"
 	VarLiteralNode   NodeType = "VarLiteral"
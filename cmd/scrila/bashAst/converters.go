@@ -45,6 +45,11 @@ func StmtToComment(stmt IStatement) IComment {
 	return i.(IComment)
 }
 
+func StmtToFloatLiteral(stmt IStatement) IFloatLiteral {
+	var i interface{} = stmt
+	return i.(IFloatLiteral)
+}
+
 func StmtToForStmt(stmt IStatement) IForStmt {
 	var i interface{} = stmt
 	return i.(IForStmt)
@@ -70,6 +75,11 @@ func StmtToMemberExpr(stmt IStatement) IMemberExpr {
 	return i.(IMemberExpr)
 }
 
+func StmtToPipeline(stmt IStatement) IPipeline {
+	var i interface{} = stmt
+	return i.(IPipeline)
+}
+
 func StmtToProgram(stmt IStatement) IProgram {
 	var i interface{} = stmt
 	return i.(IProgram)
@@ -85,6 +95,11 @@ func StmtToVarLiteral(stmt IStatement) IVarLiteral {
 	return i.(IVarLiteral)
 }
 
+func StmtToWhileReadStmt(stmt IStatement) IWhileReadStmt {
+	var i interface{} = stmt
+	return i.(IWhileReadStmt)
+}
+
 func StmtToWhileStmt(stmt IStatement) IWhileStmt {
 	var i interface{} = stmt
 	return i.(IWhileStmt)
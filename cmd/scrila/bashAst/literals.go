@@ -86,6 +86,40 @@ func (self *BoolLiteral) GetValue() bool {
 	return self.value
 }
 
+// FloatLiteral
+
+type IFloatLiteral interface {
+	IStatement
+	GetValue() float64
+}
+
+type FloatLiteral struct {
+	stmt  *Statement
+	value float64
+}
+
+func (self *FloatLiteral) String() string {
+	indentDepth++
+	str := fmt.Sprintf("{%s - value: %g}", self.GetKind(), self.GetValue())
+	indentDepth--
+	return str
+}
+
+func NewFloatLiteral(value float64) *FloatLiteral {
+	return &FloatLiteral{
+		stmt:  NewStatement(FloatLiteralNode),
+		value: value,
+	}
+}
+
+func (self *FloatLiteral) GetKind() NodeType {
+	return self.stmt.GetKind()
+}
+
+func (self *FloatLiteral) GetValue() float64 {
+	return self.value
+}
+
 // IntLiteral
 
 type IIntLiteral interface {
@@ -274,12 +274,113 @@ func (self *IfStmt) SetElse(elseBlock IIfStmt) {
 	self.elseBlock = elseBlock
 }
 
+// Pipeline
+
+type IPipeline interface {
+	IStatement
+	GetCommands() []IStatement
+	GetRedirections() []IRedirection
+	AppendRedirection(redirection IRedirection)
+}
+
+type Pipeline struct {
+	stmt         *Statement
+	commands     []IStatement
+	redirections []IRedirection
+}
+
+func (self *Pipeline) String() string {
+	indentDepth++
+	str := fmt.Sprintf("{%s", self.GetKind())
+	str += fmt.Sprintf("\n%scommands:", indent())
+	indentDepth++
+	for _, command := range self.GetCommands() {
+		str += fmt.Sprintf("\n%s%s", indent(), command)
+	}
+	indentDepth--
+	if len(self.GetRedirections()) > 0 {
+		str += fmt.Sprintf("\n%sredirections:", indent())
+		indentDepth++
+		for _, redirection := range self.GetRedirections() {
+			str += fmt.Sprintf("\n%s%s", indent(), redirection)
+		}
+		indentDepth--
+	}
+	indentDepth--
+	return str + "}"
+}
+
+// Creates a pipeline whose commands are evaluated by the shell. The exit code is the one of the last failing command (pipefail).
+func NewPipeline(commands []IStatement) *Pipeline {
+	return &Pipeline{
+		stmt:         NewStatement(PipelineNode),
+		commands:     commands,
+		redirections: make([]IRedirection, 0),
+	}
+}
+
+func (self *Pipeline) GetKind() NodeType {
+	return self.stmt.GetKind()
+}
+
+func (self *Pipeline) GetCommands() []IStatement {
+	return self.commands
+}
+
+func (self *Pipeline) GetRedirections() []IRedirection {
+	return self.redirections
+}
+
+func (self *Pipeline) AppendRedirection(redirection IRedirection) {
+	self.redirections = append(self.redirections, redirection)
+}
+
+// Pipeline - Redirection
+
+type IRedirection interface {
+	IStatement
+	GetOperator() string
+	GetTarget() IStatement
+}
+
+type Redirection struct {
+	stmt     *Statement
+	operator string
+	target   IStatement
+}
+
+func (self *Redirection) String() string {
+	return fmt.Sprintf("{%s - operator: %s, target: %s}", self.GetKind(), self.GetOperator(), self.GetTarget())
+}
+
+// Creates a redirection of the whole pipeline e.g. "> file". The target is nil for redirections between file descriptors e.g. "2>&1".
+func NewRedirection(operator string, target IStatement) *Redirection {
+	return &Redirection{
+		stmt:     NewStatement(RedirectionNode),
+		operator: operator,
+		target:   target,
+	}
+}
+
+func (self *Redirection) GetKind() NodeType {
+	return self.stmt.GetKind()
+}
+
+func (self *Redirection) GetOperator() string {
+	return self.operator
+}
+
+func (self *Redirection) GetTarget() IStatement {
+	return self.target
+}
+
 // Program
 
 type IProgram interface {
 	IStatement
 	AppendNativeBody(stmt IStatement)
 	AppendUserBody(stmt IStatement)
+	PrependUserBody(stmts ...IStatement)
 	GetNativeBody() []IStatement
 	GetUserBody() []IStatement
 }
@@ -306,6 +407,10 @@ func (self *Program) AppendUserBody(stmt IStatement) {
 	self.userBody = append(self.userBody, stmt)
 }
 
+func (self *Program) PrependUserBody(stmts ...IStatement) {
+	self.userBody = append(stmts, self.userBody...)
+}
+
 func (self *Program) GetKind() NodeType {
 	return self.stmt.GetKind()
 }
@@ -331,3 +436,71 @@ func NewWhileStmt(condition IStatement) *IfStmt {
 	whileStmt.stmt.kind = WhileStmtNode
 	return whileStmt
 }
+
+// WhileReadStmt
+
+type IWhileReadStmt interface {
+	IAppendBody
+	GetIndex() IVarLiteral
+	GetSource() IStatement
+	IsCommand() bool
+	GetBody() []IStatement
+}
+
+type WhileReadStmt struct {
+	statement *Statement
+	index     IVarLiteral
+	source    IStatement
+	isCommand bool
+	body      []IStatement
+}
+
+func (self *WhileReadStmt) String() string {
+	indentDepth++
+	str := fmt.Sprintf("{%s - isCommand: %t,\n%sindex: %s\n%ssource: %s", self.GetKind(), self.IsCommand(), indent(), self.GetIndex(), indent(), self.GetSource())
+	if len(self.GetBody()) > 0 {
+		str += fmt.Sprintf("\n%sbody:", indent())
+		indentDepth++
+		for _, stmt := range self.GetBody() {
+			str += fmt.Sprintf("\n%s%s", indent(), stmt)
+		}
+		indentDepth--
+	}
+	indentDepth--
+	return str + "}"
+}
+
+// Creates a loop that reads the lines of a file or the output of a command (isCommand) one by one
+func NewWhileReadStmt(index IVarLiteral, source IStatement, isCommand bool) *WhileReadStmt {
+	return &WhileReadStmt{
+		statement: NewStatement(WhileReadStmtNode),
+		index:     index,
+		source:    source,
+		isCommand: isCommand,
+		body:      make([]IStatement, 0),
+	}
+}
+
+func (self *WhileReadStmt) GetKind() NodeType {
+	return self.statement.GetKind()
+}
+
+func (self *WhileReadStmt) GetIndex() IVarLiteral {
+	return self.index
+}
+
+func (self *WhileReadStmt) GetSource() IStatement {
+	return self.source
+}
+
+func (self *WhileReadStmt) IsCommand() bool {
+	return self.isCommand
+}
+
+func (self *WhileReadStmt) GetBody() []IStatement {
+	return self.body
+}
+
+func (self *WhileReadStmt) AppendBody(stmt IStatement) {
+	self.body = append(self.body, stmt)
+}
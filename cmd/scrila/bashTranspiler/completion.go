@@ -0,0 +1,88 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var completionCommandRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+var completionFuncNameReplacer = strings.NewReplacer(".", "_", "-", "_")
+
+// Generates a Bash completion for the given command from the flags declared in the transpiled program.
+// It completes flag names, the values of enum flags, and file names for string flags and arguments.
+// Must be called after Transpile.
+func (self *Transpiler) TranspileCompletion(command string) (bashAst.IProgram, error) {
+	if !completionCommandRegex.MatchString(command) {
+		return nil, fmt.Errorf("Invalid command name '%s' for the completion", command)
+	}
+
+	program := bashAst.NewProgram()
+	funcName := fmt.Sprintf("_%s_completion", completionFuncNameReplacer.Replace(command))
+	funcDecl := bashAst.NewFuncDeclaration(funcName, bashAst.VoidNode)
+	funcDecl.AppendBody(bashAst.NewBashStmt("local cur=\"${COMP_WORDS[COMP_CWORD]}\""))
+	funcDecl.AppendBody(bashAst.NewBashStmt("local prev=\"${COMP_WORDS[COMP_CWORD - 1]}\""))
+	funcDecl.AppendBody(bashAst.NewBashStmt("COMPREPLY=()"))
+
+	flagNames := []string{}
+	if len(self.flags) > 0 {
+		// Complete "--flag=value" like "--flag value". Depending on COMP_WORDBREAKS the "=" is part of the word
+		// (the completed value needs the flag as prefix) or a word of its own.
+		funcDecl.AppendBody(bashAst.NewBashStmt("local prefix=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${cur} == --*=* ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tprev=\"${cur%%=*}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tprefix=\"${prev}=\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tcur=\"${cur#*=}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("elif [[ ${cur} == \"=\" && ${prev} == --* ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tcur=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("elif [[ ${prev} == \"=\" && ${COMP_WORDS[COMP_CWORD - 2]} == --* ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tprev=\"${COMP_WORDS[COMP_CWORD - 2]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+
+		// Complete the value of the previous flag
+		funcDecl.AppendBody(bashAst.NewBashStmt("case \"${prev}\" in"))
+		for _, flag := range self.flags {
+			flagNames = append(flagNames, "--"+flag.name)
+			pattern := "--" + flag.name
+			if flag.short != "" {
+				flagNames = append(flagNames, "-"+flag.short)
+				pattern = fmt.Sprintf("-%s|%s", flag.short, pattern)
+			}
+
+			switch {
+			case len(flag.choices) > 0:
+				funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("\t%s)", pattern)))
+				funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("\t\tmapfile -t COMPREPLY < <(compgen -W \"%s\" -P \"${prefix}\" -- \"${cur}\")", strings.Join(flag.choices, " "))))
+			case flagTypeBashMapping[flag.dataType] == "str":
+				funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("\t%s)", pattern)))
+				funcDecl.AppendBody(bashAst.NewBashStmt("\t\tmapfile -t COMPREPLY < <(compgen -f -P \"${prefix}\" -- \"${cur}\")"))
+			case flagTypeBashMapping[flag.dataType] == "int":
+				// Nothing to complete for numbers
+				funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("\t%s)", pattern)))
+			default:
+				continue
+			}
+			funcDecl.AppendBody(bashAst.NewBashStmt("\t\treturn"))
+			funcDecl.AppendBody(bashAst.NewBashStmt("\t\t;;"))
+		}
+		funcDecl.AppendBody(bashAst.NewBashStmt("esac"))
+		flagNames = append(flagNames, "--help", "-h")
+
+		// Complete the flag names
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${cur} == -* ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("\tmapfile -t COMPREPLY < <(compgen -W \"%s\" -- \"${cur}\")", strings.Join(flagNames, " "))))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\treturn"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+	}
+
+	// Complete file names for the arguments
+	funcDecl.AppendBody(bashAst.NewBashStmt("mapfile -t COMPREPLY < <(compgen -f -- \"${cur}\")"))
+	program.AppendNativeBody(funcDecl)
+	program.AppendUserBody(bashAst.NewBashStmt(fmt.Sprintf("complete -o filenames -F %s %s", funcName, command)))
+	return program, nil
+}
@@ -4,8 +4,24 @@ import (
 	"ScriLa/cmd/scrila/bashAst"
 	"ScriLa/cmd/scrila/scrilaAst"
 	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Unescapes a string literal the same way Bash does for a string in double quotes
+var bashDoubleQuotesReplacer = strings.NewReplacer(
+	`\\`, `\`,
+	`\"`, `"`,
+	`\$`, `$`,
+	"\\`", "`",
 )
 
+// Quotes a string literal in single quotes so that Bash uses the text as written
+func bashSingleQuote(value string) string {
+	return "'" + strings.ReplaceAll(bashDoubleQuotesReplacer.Replace(value), "'", `'\''`) + "'"
+}
+
 // Returns the symbol of the given expr of kind Identifier
 func identNodeGetSymbol(expr scrilaAst.IExpr) string {
 	return scrilaAst.ExprToIdent(expr).GetSymbol()
@@ -68,6 +84,8 @@ func (self *Transpiler) exprToBashStmt(expr scrilaAst.IExpr, env *Environment) (
 		return bashAst.NewVarLiteral(returnVarName, bashReturnType), nil
 	case scrilaAst.ContinueExprNode:
 		return bashAst.NewContinueExpr(), nil
+	case scrilaAst.FloatLiteralNode:
+		return bashAst.NewFloatLiteral(scrilaAst.ExprToFloatLit(expr).GetValue()), nil
 	case scrilaAst.IdentifierNode:
 		varName := identNodeGetSymbol(expr)
 		scrilaVarType, err := env.lookupVarType(varName)
@@ -99,13 +117,18 @@ func runtimeToFuncVal(runtimeVal scrilaAst.IRuntimeVal) IFunctionVal {
 }
 
 var scrilaNodeTypeToBashNodeTypeMapping = map[scrilaAst.NodeType]bashAst.NodeType{
-	scrilaAst.BoolArrayNode:   bashAst.BoolArrayNode,
-	scrilaAst.BoolLiteralNode: bashAst.BoolLiteralNode,
-	scrilaAst.IntArrayNode:    bashAst.IntArrayNode,
-	scrilaAst.IntLiteralNode:  bashAst.IntLiteralNode,
-	scrilaAst.StrArrayNode:    bashAst.StrArrayNode,
-	scrilaAst.StrLiteralNode:  bashAst.StrLiteralNode,
-	scrilaAst.VoidNode:        bashAst.VoidNode,
+	scrilaAst.BoolArrayNode:    bashAst.BoolArrayNode,
+	scrilaAst.BoolLiteralNode:  bashAst.BoolLiteralNode,
+	scrilaAst.ExecResultNode:   bashAst.ExecResultNode,
+	scrilaAst.FloatArrayNode:   bashAst.FloatArrayNode,
+	scrilaAst.FloatLiteralNode: bashAst.FloatLiteralNode,
+	scrilaAst.IntArrayNode:     bashAst.IntArrayNode,
+	scrilaAst.IntLiteralNode:   bashAst.IntLiteralNode,
+	scrilaAst.JobArrayNode:     bashAst.JobArrayNode,
+	scrilaAst.JobNode:          bashAst.JobNode,
+	scrilaAst.StrArrayNode:     bashAst.StrArrayNode,
+	scrilaAst.StrLiteralNode:   bashAst.StrLiteralNode,
+	scrilaAst.VoidNode:         bashAst.VoidNode,
 }
 
 func scrilaNodeTypeToBashNodeType(nodeType scrilaAst.NodeType) (bashAst.NodeType, error) {
@@ -125,14 +148,42 @@ func bashNodeTypeToScrilaNodeType(nodeType bashAst.NodeType) (scrilaAst.NodeType
 	return "", fmt.Errorf("scrilaNodeTypeToBashNodeType(): Type '%s' is not in mapping", nodeType)
 }
 
+func scrilaNodeTypeIsArray(nodeType scrilaAst.NodeType) bool {
+	return slices.Contains([]scrilaAst.NodeType{scrilaAst.BoolArrayNode, scrilaAst.FloatArrayNode, scrilaAst.IntArrayNode, scrilaAst.JobArrayNode, scrilaAst.StrArrayNode}, nodeType)
+}
+
+func isNumericValueType(valueType scrilaAst.ValueType) bool {
+	return slices.Contains([]scrilaAst.ValueType{scrilaAst.FloatValueType, scrilaAst.IntValueType}, valueType)
+}
+
+// An int is promoted to a float if a float is wanted
+func isIntPromotedToFloat(wantedType scrilaAst.NodeType, givenType scrilaAst.NodeType) bool {
+	return wantedType == scrilaAst.FloatLiteralNode && givenType == scrilaAst.IntLiteralNode
+}
+
+// Converts the bash statement of an int to a float.
+// Int literals become float literals. Other values get the format of a float (e.g. "3.0") at runtime by "floatCalc".
+func (self *Transpiler) intToFloatBashStmt(stmt bashAst.IStatement) bashAst.IStatement {
+	if stmt.GetKind() == bashAst.IntLiteralNode {
+		return bashAst.NewFloatLiteral(float64(bashAst.StmtToIntLiteral(stmt).GetValue()))
+	}
+	self.appendFloatCalcFunc()
+	return bashAst.NewBinaryOpExpr(bashAst.FloatLiteralNode, stmt, bashAst.NewFloatLiteral(0), "+")
+}
+
 var scrilaNodeTypeToRuntimeValMapping = map[scrilaAst.NodeType]scrilaAst.IRuntimeVal{
-	scrilaAst.BoolArrayNode:   NewArrayVal(scrilaAst.BoolArrayValueType),
-	scrilaAst.BoolLiteralNode: NewBoolVal(true),
-	scrilaAst.IntArrayNode:    NewArrayVal(scrilaAst.IntArrayValueType),
-	scrilaAst.IntLiteralNode:  NewIntVal(1),
-	scrilaAst.VoidNode:        NewNullVal(),
-	scrilaAst.StrArrayNode:    NewArrayVal(scrilaAst.StrArrayValueType),
-	scrilaAst.StrLiteralNode:  NewStrVal("str"),
+	scrilaAst.BoolArrayNode:    NewArrayVal(scrilaAst.BoolArrayValueType),
+	scrilaAst.BoolLiteralNode:  NewBoolVal(true),
+	scrilaAst.ExecResultNode:   scrilaAst.NewRuntimeVal(scrilaAst.ExecResultValueType),
+	scrilaAst.FloatArrayNode:   NewArrayVal(scrilaAst.FloatArrayValueType),
+	scrilaAst.FloatLiteralNode: NewFloatVal(1),
+	scrilaAst.IntArrayNode:     NewArrayVal(scrilaAst.IntArrayValueType),
+	scrilaAst.IntLiteralNode:   NewIntVal(1),
+	scrilaAst.JobArrayNode:     NewArrayVal(scrilaAst.JobArrayValueType),
+	scrilaAst.JobNode:          scrilaAst.NewRuntimeVal(scrilaAst.JobValueType),
+	scrilaAst.VoidNode:         NewNullVal(),
+	scrilaAst.StrArrayNode:     NewArrayVal(scrilaAst.StrArrayValueType),
+	scrilaAst.StrLiteralNode:   NewStrVal("str"),
 }
 
 func scrilaNodeTypeToRuntimeVal(nodeType scrilaAst.NodeType) (scrilaAst.IRuntimeVal, error) {
@@ -144,12 +195,18 @@ func scrilaNodeTypeToRuntimeVal(nodeType scrilaAst.NodeType) (scrilaAst.IRuntime
 }
 
 var scrilaNodeTypeToTmpVarNameMapping = map[scrilaAst.NodeType]string{
-	scrilaAst.BoolLiteralNode: "tmpBools",
-	scrilaAst.IntLiteralNode:  "tmpInts",
-	scrilaAst.StrLiteralNode:  "tmpStrs",
+	scrilaAst.BoolLiteralNode:  "tmpBools",
+	scrilaAst.FloatLiteralNode: "tmpFloats",
+	scrilaAst.IntLiteralNode:   "tmpInts",
+	scrilaAst.JobNode:          "tmpInts",
+	scrilaAst.StrLiteralNode:   "tmpStrs",
 }
 
 func (self *Transpiler) scrilaNodeTypeToTmpVarName(nodeType scrilaAst.NodeType) (string, error) {
+	// The fields of an exec result are returned as a whole array
+	if nodeType == scrilaAst.ExecResultNode {
+		return "tmpStrs", nil
+	}
 	value, ok := scrilaNodeTypeToTmpVarNameMapping[nodeType]
 	if !ok {
 		// If nodeType is an array, the result shall be the array name without any index
@@ -170,6 +227,22 @@ func (self *Transpiler) scrilaNodeTypeToTmpVarName(nodeType scrilaAst.NodeType)
 	return fmt.Sprintf("%s[%d]", value, index), nil
 }
 
+// Returns the name of the temporary array without any index e.g. "tmpInts" for 'IntLiteral' and 'IntArray'
+func scrilaNodeTypeToTmpArrayName(nodeType scrilaAst.NodeType) (string, error) {
+	if scrilaNodeTypeIsArray(nodeType) {
+		dataType, err := scrilaAst.ArrayTypeToDataType(nodeType)
+		if err != nil {
+			return "", err
+		}
+		nodeType = dataType
+	}
+	value, ok := scrilaNodeTypeToTmpVarNameMapping[nodeType]
+	if !ok {
+		return "", fmt.Errorf("scrilaNodeTypeToTmpArrayName(): Node type '%s' is not in mapping", nodeType)
+	}
+	return value, nil
+}
+
 func (self *Transpiler) scrilaNodeTypeToDynTmpVarName(nodeType scrilaAst.NodeType) (string, error) {
 	value, ok := scrilaNodeTypeToTmpVarNameMapping[nodeType]
 	if !ok {
@@ -204,7 +277,11 @@ func (self *Transpiler) getFuncReturnType(call scrilaAst.ICallExpr, env *Environ
 	case scrilaAst.FunctionValueType:
 		return runtimeToFuncVal(caller).GetReturnType(), nil
 	case scrilaAst.NativeFnType:
-		return runtimeToNativeFunc(caller).GetReturnType(), nil
+		nativeFunc := runtimeToNativeFunc(caller)
+		if nativeFunc.IsGeneric() {
+			return nativeFunc.GetReturnTypeFn()(call.GetArgs(), env)
+		}
+		return nativeFunc.GetReturnType(), nil
 	default:
 		return "", fmt.Errorf("%s: Cannot call value that is not a function: %s", self.getPos(call), caller.GetType())
 	}
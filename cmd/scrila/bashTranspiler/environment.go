@@ -11,6 +11,7 @@ func (self *Transpiler) setupScope(env *Environment) {
 	// Variables used for internal use
 	env.declareVar("tmpStrs", false, scrilaAst.StrArrayNode)
 	env.declareVar("tmpInts", false, scrilaAst.IntArrayNode)
+	env.declareVar("tmpFloats", false, scrilaAst.FloatArrayNode)
 	env.declareVar("tmpBools", false, scrilaAst.BoolArrayNode)
 	env.declareVar("tmpIndex", false, scrilaAst.IntLiteralNode)
 
@@ -19,19 +20,21 @@ func (self *Transpiler) setupScope(env *Environment) {
 }
 
 type Environment struct {
-	parent    *Environment
-	functions map[string]scrilaAst.IRuntimeVal
-	variables map[string]scrilaAst.NodeType
-	constants []string
+	parent     *Environment
+	transpiler *Transpiler
+	functions  map[string]scrilaAst.IRuntimeVal
+	variables  map[string]scrilaAst.NodeType
+	constants  []string
 }
 
 func NewEnvironment(parentEnv *Environment, transpiler *Transpiler) *Environment {
 	isGlobal := parentEnv == nil
 	env := &Environment{
-		parent:    parentEnv,
-		functions: make(map[string]scrilaAst.IRuntimeVal),
-		variables: make(map[string]scrilaAst.NodeType),
-		constants: make([]string, 0),
+		parent:     parentEnv,
+		transpiler: transpiler,
+		functions:  make(map[string]scrilaAst.IRuntimeVal),
+		variables:  make(map[string]scrilaAst.NodeType),
+		constants:  make([]string, 0),
 	}
 
 	if isGlobal {
@@ -86,8 +89,13 @@ func (self *Environment) declareVar(varName string, isConstant bool, varType scr
 	if _, ok := self.variables[varName]; ok {
 		return NewNullVal(), fmt.Errorf("Cannot declare variable '%s' as it already is defined", varName)
 	}
+	// A ScriLa variable with the same name as an environment variable would change the environment of the script
+	if slices.Contains(self.transpiler.envVarNames, varName) {
+		return NewNullVal(), fmt.Errorf("Cannot declare variable '%s' as the name is used for an environment variable", varName)
+	}
 
 	self.variables[varName] = varType
+	self.transpiler.varNames = append(self.transpiler.varNames, varName)
 
 	if isConstant {
 		self.constants = append(self.constants, varName)
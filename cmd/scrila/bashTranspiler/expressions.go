@@ -115,6 +115,18 @@ func (self *Transpiler) evalBinaryExpr(binOp scrilaAst.IBinaryExpr, env *Environ
 			binOp.SetResult(result)
 		}
 
+		// An int is promoted to a float if the other operand is a float
+		if isNumericValueType(lhs.GetType()) && isNumericValueType(rhs.GetType()) &&
+			(lhs.GetType() == scrilaAst.FloatValueType || rhs.GetType() == scrilaAst.FloatValueType) {
+			opType = bashAst.FloatLiteralNode
+			if !slices.Contains([]string{"+", "-", "*", "/"}, binOp.GetOperator()) {
+				return NewNullVal(), fmt.Errorf("%s: Binary float expression with unsupported operator '%s'", self.getPos(binOp), binOp.GetOperator())
+			}
+			self.appendFloatCalcFunc()
+			result = NewFloatVal(1)
+			binOp.SetResult(result)
+		}
+
 		if lhs.GetType() == scrilaAst.StrValueType && rhs.GetType() == scrilaAst.StrValueType {
 			opType = bashAst.StrLiteralNode
 			if binOp.GetOperator() != "+" {
@@ -143,6 +155,11 @@ func (self *Transpiler) evalBinaryExpr(binOp scrilaAst.IBinaryExpr, env *Environ
 	if bashRhs == nil {
 		return NewNullVal(), fmt.Errorf("evalBinaryExpr(): RHS is nil")
 	}
+	if !isComparison && binOp.GetOperator() == "/" {
+		if err := validateDivisor(binOp.GetRight()); err != nil {
+			return NewNullVal(), fmt.Errorf("%s: %s", self.getPos(binOp), err)
+		}
+	}
 	if isComparison {
 		self.bashStmtStack[binOp.GetId()] = bashAst.NewBinaryCompExpr(opType, bashLhs, bashRhs, binOp.GetOperator())
 	} else {
@@ -154,6 +171,13 @@ func (self *Transpiler) evalBinaryExpr(binOp scrilaAst.IBinaryExpr, env *Environ
 func (self *Transpiler) evalComparisonBinaryExpr(lhs scrilaAst.IRuntimeVal, rhs scrilaAst.IRuntimeVal, operator string) (bashAst.NodeType, error) {
 	self.printFuncName("")
 
+	// An int is promoted to a float if the other operand is a float
+	if isNumericValueType(lhs.GetType()) && isNumericValueType(rhs.GetType()) &&
+		(lhs.GetType() == scrilaAst.FloatValueType || rhs.GetType() == scrilaAst.FloatValueType) {
+		self.appendFloatCalcFunc()
+		return bashAst.FloatLiteralNode, nil
+	}
+
 	if lhs.GetType() != rhs.GetType() {
 		return "", fmt.Errorf("Cannot compare type '%s' and '%s'", lhs.GetType(), rhs.GetType())
 	}
@@ -205,7 +229,8 @@ func (self *Transpiler) evalAssignment(assignment scrilaAst.IAssignmentExpr, env
 	if err != nil {
 		return NewNullVal(), err
 	}
-	if !doMatch {
+	isPromoted := isIntPromotedToFloat(varType, givenType)
+	if !doMatch && !isPromoted {
 		return NewNullVal(), fmt.Errorf("%s: Cannot assign a value of type '%s' to a var of type '%s'", self.getPos(assignment.GetValue()), givenType, varType)
 	}
 
@@ -217,6 +242,9 @@ func (self *Transpiler) evalAssignment(assignment scrilaAst.IAssignmentExpr, env
 	if err != nil {
 		return NewNullVal(), err
 	}
+	if isPromoted {
+		bashStmt = self.intToFloatBashStmt(bashStmt)
+	}
 	self.appendUserBody(bashAst.NewAssignmentExpr(
 		bashAst.NewVarLiteral(varName, bashVarType),
 		bashStmt,
@@ -244,6 +272,10 @@ func (self *Transpiler) evalAssignmentObjMember(assignment scrilaAst.IAssignment
 
 	memberExpr := scrilaAst.ExprToMemberExpr(assignment.GetAssigne())
 
+	if !memberExpr.IsComputed() {
+		return NewNullVal(), fmt.Errorf("%s: Cannot assign a value to the field '%s'. Fields are read-only", self.getPos(memberExpr.GetProperty()), identNodeGetSymbol(memberExpr.GetProperty()))
+	}
+
 	if memberExpr.GetObject().GetKind() != scrilaAst.IdentifierNode {
 		return NewNullVal(), fmt.Errorf("%s: Array name is not the right type. Got '%s'", self.getPos(memberExpr.GetObject()), memberExpr.GetObject().GetKind())
 	}
@@ -270,7 +302,8 @@ func (self *Transpiler) evalAssignmentObjMember(assignment scrilaAst.IAssignment
 	if err != nil {
 		return NewNullVal(), err
 	}
-	if runtimeArray != obj.GetType() {
+	isPromoted := obj.GetType() == scrilaAst.FloatArrayValueType && runtimeValue.GetType() == scrilaAst.IntValueType
+	if runtimeArray != obj.GetType() && !isPromoted {
 		return NewNullVal(), fmt.Errorf("%s: Cannot assign a value of type '%s' to array of type '%s'", self.getPos(assignment.GetValue()), assignment.GetValue().GetKind(), obj.GetType())
 	}
 
@@ -278,6 +311,9 @@ func (self *Transpiler) evalAssignmentObjMember(assignment scrilaAst.IAssignment
 	if err != nil {
 		return NewNullVal(), err
 	}
+	if isPromoted {
+		bashStmt = self.intToFloatBashStmt(bashStmt)
+	}
 	var bashIndex bashAst.IStatement = bashAst.NewStatement(bashAst.VoidNode)
 	if !memberExpr.IsEmpty() {
 		bashIndex, err = self.exprToBashStmt(memberExpr.GetProperty(), env)
@@ -309,6 +345,10 @@ func (self *Transpiler) evalObjectExpr(object scrilaAst.IObjectLiteral, env *Env
 func (self *Transpiler) evalMemberExpr(memberExpr scrilaAst.IMemberExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
 	self.printFuncName("")
 
+	if !memberExpr.IsComputed() {
+		return self.evalFieldMemberExpr(memberExpr, env)
+	}
+
 	if memberExpr.GetObject().GetKind() != scrilaAst.IdentifierNode {
 		return NewNullVal(), fmt.Errorf("%s: Array name is not the right type. Got '%s'", self.getPos(memberExpr.GetObject()), memberExpr.GetObject().GetKind())
 	}
@@ -346,6 +386,34 @@ func (self *Transpiler) evalMemberExpr(memberExpr scrilaAst.IMemberExpr, env *En
 	return scrilaAst.NewRuntimeVal(dataType), nil
 }
 
+// Evaluates a field access e.g. result.stdout
+func (self *Transpiler) evalFieldMemberExpr(memberExpr scrilaAst.IMemberExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	if memberExpr.GetObject().GetKind() != scrilaAst.IdentifierNode {
+		return NewNullVal(), fmt.Errorf("%s: Fields can only be accessed on variables. Got '%s'", self.getPos(memberExpr.GetObject()), memberExpr.GetObject().GetKind())
+	}
+
+	objName := identNodeGetSymbol(memberExpr.GetObject())
+	objType, err := env.lookupVarType(objName)
+	if err != nil {
+		return NewNullVal(), fmt.Errorf("%s: %s", self.getPos(memberExpr.GetObject()), err)
+	}
+	if objType != scrilaAst.ExecResultNode {
+		return NewNullVal(), fmt.Errorf("%s: Variable '%s' of type '%s' has no fields", self.getPos(memberExpr.GetObject()), objName, objType)
+	}
+
+	fieldName := identNodeGetSymbol(memberExpr.GetProperty())
+	fieldIndex, err := getExecResultFieldIndex(fieldName)
+	if err != nil {
+		return NewNullVal(), fmt.Errorf("%s: %s", self.getPos(memberExpr.GetProperty()), err)
+	}
+
+	self.bashStmtStack[memberExpr.GetId()] = bashAst.NewMemberExpr(bashAst.NewVarLiteral(objName, bashAst.ExecResultNode), bashAst.NewIntLiteral(int64(fieldIndex)))
+
+	return scrilaNodeTypeToRuntimeVal(execResultFields[fieldIndex].dataType)
+}
+
 func (self *Transpiler) evalCallExpr(call scrilaAst.ICallExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
 	if call.GetCaller().GetKind() != scrilaAst.IdentifierNode {
 		return NewNullVal(), fmt.Errorf("%s: Function name must be an identifier. Got: '%s'", self.getPos(call.GetCaller()), call.GetCaller().GetKind())
@@ -358,6 +426,17 @@ func (self *Transpiler) evalCallExpr(call scrilaAst.ICallExpr, env *Environment)
 	bashArgs := make([]bashAst.IStatement, 0)
 	var args []scrilaAst.IRuntimeVal
 	for _, arg := range call.GetArgs() {
+		// A user defined function passed as argument is passed by its name
+		if self.exprIsFuncRef(arg, env) {
+			fn, err := env.lookupFunc(identNodeGetSymbol(arg))
+			if err != nil {
+				return NewNullVal(), err
+			}
+			args = append(args, fn)
+			bashArgs = append(bashArgs, bashAst.NewStrLiteral(identNodeGetSymbol(arg)))
+			continue
+		}
+
 		evalArg, err := self.transpile(arg, env)
 		if err != nil {
 			return NewNullVal(), err
@@ -380,15 +459,37 @@ func (self *Transpiler) evalCallExpr(call scrilaAst.ICallExpr, env *Environment)
 	var result scrilaAst.IRuntimeVal
 	switch caller.GetType() {
 	case scrilaAst.NativeFnType:
-		result, err = runtimeToNativeFunc(caller).GetCall()(call.GetArgs(), env)
+		nativeFunc := runtimeToNativeFunc(caller)
+		result, err = nativeFunc.GetCall()(call.GetArgs(), env)
 		if err != nil {
 			return NewNullVal(), fmt.Errorf("%s: %s", self.getPos(call), err)
 		}
 
+		// Generic native functions get the name of the temporary array for their result as first argument
+		if nativeFunc.IsGeneric() {
+			returnType, err := self.getFuncReturnType(call, env)
+			if err != nil {
+				return NewNullVal(), fmt.Errorf("%s: %s", self.getPos(call), err)
+			}
+			if returnType != scrilaAst.VoidNode {
+				resultVarName, err := scrilaNodeTypeToTmpArrayName(returnType)
+				if err != nil {
+					return NewNullVal(), err
+				}
+				bashArgs = append([]bashAst.IStatement{bashAst.NewStrLiteral(resultVarName)}, bashArgs...)
+			}
+		}
+
 		if result.GetType() != scrilaAst.NullValueType {
 			self.setCallArgIndex()
 		}
-		self.appendUserBody(bashAst.NewCallExpr(funcName, bashArgs))
+		if nativeFunc.IsInline() {
+			for _, stmt := range nativeFunc.GetInlineFn()(bashArgs) {
+				self.appendUserBody(stmt)
+			}
+		} else {
+			self.appendUserBody(bashAst.NewCallExpr(nativeBashFuncName(funcName), bashArgs))
+		}
 
 	case scrilaAst.FunctionValueType:
 		fn := runtimeToFuncVal(caller)
@@ -402,17 +503,22 @@ func (self *Transpiler) evalCallExpr(call scrilaAst.ICallExpr, env *Environment)
 			return NewNullVal(), err
 		}
 
-		if result.GetType() != scrilaAst.NullValueType {
-			self.setCallArgIndex()
-		}
-		self.appendUserBody(bashAst.NewCallExpr(funcName, bashArgs))
-
 		for i, param := range fn.GetParams() {
+			// An int is promoted to a float if a float is wanted
+			if param.GetParamType() == scrilaAst.FloatLiteralNode && args[i].GetType() == scrilaAst.IntValueType {
+				bashArgs[i] = self.intToFloatBashStmt(bashArgs[i])
+				continue
+			}
 			if !scrilaAst.DoTypesMatch(param.GetParamType(), args[i].GetType()) {
 				return NewNullVal(), fmt.Errorf("%s: %s(): Parameter '%s' type does not match. Expected: %s, Got: %s", self.getPos(call), fn.GetName(), param.GetName(), param.GetParamType(), args[i].GetType())
 			}
 		}
 
+		if result.GetType() != scrilaAst.NullValueType {
+			self.setCallArgIndex()
+		}
+		self.appendUserBody(bashAst.NewCallExpr(funcName, bashArgs))
+
 	default:
 		return NewNullVal(), fmt.Errorf("%s: Cannot call value that is not a function: %s", self.getPos(call), caller)
 	}
@@ -422,8 +528,12 @@ func (self *Transpiler) evalCallExpr(call scrilaAst.ICallExpr, env *Environment)
 }
 
 func (self *Transpiler) evalWhileExitKeywords(expr scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
-	if !self.contextContains(WhileLoopContext) {
-		return NewNullVal(), fmt.Errorf("%s: '%s' is only allowed inside a while loop", self.getPos(expr), expr.GetKind())
+	if !self.contextContains(WhileLoopContext) && !self.contextContains(ForLoopContext) && !self.contextContains(ParallelContext) {
+		return NewNullVal(), fmt.Errorf("%s: '%s' is only allowed inside a for or while loop", self.getPos(expr), expr.GetKind())
+	}
+	// Each iteration of a parallel for loop runs in its own background job
+	if self.innermostContext(ForLoopContext, ParallelContext, WhileLoopContext) == ParallelContext {
+		return NewNullVal(), fmt.Errorf("%s: '%s' is not allowed inside a parallel for loop", self.getPos(expr), expr.GetKind())
 	}
 
 	bashStmt, err := self.exprToBashStmt(expr, env)
@@ -442,6 +552,9 @@ func (self *Transpiler) evalReturnExpr(returnExpr scrilaAst.IReturnExpr, env *En
 	if !self.contextContains(FunctionContext) || self.currentFunc == nil {
 		return NewNullVal(), fmt.Errorf("%s: Return is only allowed inside a function", self.getPos(returnExpr))
 	}
+	if self.innermostContext(FunctionContext, ParallelContext) == ParallelContext {
+		return NewNullVal(), fmt.Errorf("%s: Return is not allowed inside a parallel for loop", self.getPos(returnExpr))
+	}
 
 	// Check if functions of type "void" do not have a return expression with value
 	if self.currentFunc.GetReturnType() == scrilaAst.VoidNode {
@@ -463,8 +576,9 @@ func (self *Transpiler) evalReturnExpr(returnExpr scrilaAst.IReturnExpr, env *En
 		return NewNullVal(), err
 	}
 
-	// Check if the return value matches with the function type
-	if !scrilaAst.DoTypesMatch(self.currentFunc.GetReturnType(), value.GetType()) {
+	// Check if the return value matches with the function type. An int is promoted to a float if a float is wanted.
+	isPromoted := self.currentFunc.GetReturnType() == scrilaAst.FloatLiteralNode && value.GetType() == scrilaAst.IntValueType
+	if !isPromoted && !scrilaAst.DoTypesMatch(self.currentFunc.GetReturnType(), value.GetType()) {
 		return NewNullVal(), fmt.Errorf("%s: %s(): Return type does not match with function type. Expected: %s, Got: %s", self.getPos(returnExpr), self.currentFunc.GetName(), self.currentFunc.GetReturnType(), value.GetType())
 	}
 
@@ -472,6 +586,10 @@ func (self *Transpiler) evalReturnExpr(returnExpr scrilaAst.IReturnExpr, env *En
 	if err != nil {
 		return NewNullVal(), err
 	}
+	if isPromoted {
+		resultValue = self.intToFloatBashStmt(resultValue)
+		value = NewFloatVal(1)
+	}
 	resultVarName, err := self.scrilaNodeTypeToDynTmpVarName(self.currentFunc.GetReturnType())
 	if err != nil {
 		return NewNullVal(), err
@@ -482,7 +600,7 @@ func (self *Transpiler) evalReturnExpr(returnExpr scrilaAst.IReturnExpr, env *En
 	}
 	if resultValue.GetKind() != bashAst.VarLiteralNode ||
 		!slices.Contains(
-			[]string{"tmpBools", "tmpInts", "tmpStrs", "tmpBools[${tmpIndex}]", "tmpInts[${tmpIndex}]", "tmpStrs[${tmpIndex}]"},
+			[]string{"tmpBools", "tmpFloats", "tmpInts", "tmpStrs", "tmpBools[${tmpIndex}]", "tmpFloats[${tmpIndex}]", "tmpInts[${tmpIndex}]", "tmpStrs[${tmpIndex}]"},
 			bashAst.StmtToVarLiteral(resultValue).GetValue()) {
 		self.appendUserBody(bashAst.NewAssignmentExpr(
 			bashAst.NewVarLiteral(resultVarName, resultVarType),
@@ -9,20 +9,183 @@ import (
 )
 
 func (self *Transpiler) declareNativeFunctions(env *Environment) {
+	env.declareFunc("abs", NewNativeFunc(self.nativeAbs, scrilaAst.IntLiteralNode))
+	env.declareFunc("appendFile", NewNativeFunc(self.nativeAppendFile, scrilaAst.VoidNode))
+	env.declareFunc("arrAll", NewNativeFunc(self.nativeArrAll, scrilaAst.BoolLiteralNode))
+	env.declareFunc("arrAny", NewNativeFunc(self.nativeArrAny, scrilaAst.BoolLiteralNode))
+	env.declareFunc("arrContains", NewNativeFunc(self.nativeArrContains, scrilaAst.BoolLiteralNode))
+	env.declareFunc("arrFilter", NewGenericNativeFunc(self.nativeArrFilter, self.firstArgArrayType))
+	env.declareFunc("arrIndexOf", NewNativeFunc(self.nativeArrIndexOf, scrilaAst.IntLiteralNode))
+	env.declareFunc("arrInsert", NewGenericNativeFunc(self.nativeArrInsert, self.firstArgArrayType))
+	env.declareFunc("arrMap", NewGenericNativeFunc(self.nativeArrMap, self.callbackArrayType))
+	env.declareFunc("arrPop", NewGenericNativeFunc(self.nativeArrPop, self.firstArgArrayType))
+	env.declareFunc("arrPush", NewGenericNativeFunc(self.nativeArrPush, self.firstArgArrayType))
+	env.declareFunc("arrReduce", NewGenericNativeFunc(self.nativeArrReduce, self.secondArgType))
+	env.declareFunc("arrRemoveAt", NewGenericNativeFunc(self.nativeArrRemoveAt, self.firstArgArrayType))
+	env.declareFunc("arrReverse", NewGenericNativeFunc(self.nativeArrReverse, self.firstArgArrayType))
+	env.declareFunc("arrSort", NewGenericNativeFunc(self.nativeArrSort, self.firstArgArrayType))
+	env.declareFunc("arrUnique", NewGenericNativeFunc(self.nativeArrUnique, self.firstArgArrayType))
+	env.declareFunc("ceil", NewNativeFunc(self.nativeCeil, scrilaAst.IntLiteralNode))
+	env.declareFunc("clamp", NewNativeFunc(self.nativeClamp, scrilaAst.IntLiteralNode))
+	env.declareFunc("copy", NewNativeFunc(self.nativeCopy, scrilaAst.VoidNode))
+	env.declareFunc("cpuCount", NewNativeFunc(self.nativeCpuCount, scrilaAst.IntLiteralNode))
+	env.declareFunc("dirExists", NewNativeFunc(self.nativeDirExists, scrilaAst.BoolLiteralNode))
+	env.declareFunc("envGet", NewNativeFunc(self.nativeEnvGet, scrilaAst.StrLiteralNode))
+	env.declareFunc("envGetOr", NewNativeFunc(self.nativeEnvGetOr, scrilaAst.StrLiteralNode))
+	env.declareFunc("envHas", NewNativeFunc(self.nativeEnvHas, scrilaAst.BoolLiteralNode))
+	env.declareFunc("envSet", NewNativeFunc(self.nativeEnvSet, scrilaAst.VoidNode))
+	env.declareFunc("envUnset", NewNativeFunc(self.nativeEnvUnset, scrilaAst.VoidNode))
 	env.declareFunc("exec", NewNativeFunc(self.nativeExec, scrilaAst.StrLiteralNode))
+	env.declareFunc("execFull", NewNativeFunc(self.nativeExecFull, scrilaAst.ExecResultNode))
+	env.declareFunc("execLines", NewNativeFunc(self.nativeExecLines, scrilaAst.StrArrayNode))
+	env.declareFunc("execTimeout", NewNativeFunc(self.nativeExecTimeout, scrilaAst.StrLiteralNode))
 	env.declareFunc("exit", NewNativeFunc(self.nativeExit, scrilaAst.VoidNode))
+	env.declareFunc("fileExists", NewNativeFunc(self.nativeFileExists, scrilaAst.BoolLiteralNode))
+	env.declareFunc("fileSize", NewNativeFunc(self.nativeFileSize, scrilaAst.IntLiteralNode))
+	env.declareFunc("flagBool", NewNativeFunc(self.nativeFlagBool, scrilaAst.BoolLiteralNode))
+	env.declareFunc("flagEnum", NewNativeFunc(self.nativeFlagEnum, scrilaAst.StrLiteralNode))
+	env.declareFunc("flagInt", NewNativeFunc(self.nativeFlagInt, scrilaAst.IntLiteralNode))
+	env.declareFunc("flagIntRequired", NewNativeFunc(self.nativeFlagIntRequired, scrilaAst.IntLiteralNode))
+	env.declareFunc("flagStr", NewNativeFunc(self.nativeFlagStr, scrilaAst.StrLiteralNode))
+	env.declareFunc("flagStrRequired", NewNativeFunc(self.nativeFlagStrRequired, scrilaAst.StrLiteralNode))
+	env.declareFunc("floatToStr", NewNativeFunc(self.nativeFloatToStr, scrilaAst.StrLiteralNode))
+	env.declareFunc("floor", NewNativeFunc(self.nativeFloor, scrilaAst.IntLiteralNode))
+	env.declareFunc("format", NewNativeFunc(self.nativeFormat, scrilaAst.StrLiteralNode))
+	env.declareFunc("gcd", NewNativeFunc(self.nativeGcd, scrilaAst.IntLiteralNode))
+	env.declareFunc("glob", NewNativeFunc(self.nativeGlob, scrilaAst.StrArrayNode))
+	env.declareFunc("hostname", NewNativeFunc(self.nativeHostname, scrilaAst.StrLiteralNode))
+	env.declareFunc("ignoreSignal", NewInlineNativeFunc(self.nativeIgnoreSignal, self.inlineIgnoreSignal, scrilaAst.VoidNode))
 	env.declareFunc("input", NewNativeFunc(self.nativeInput, scrilaAst.StrLiteralNode))
+	env.declareFunc("inputSecret", NewNativeFunc(self.nativeInputSecret, scrilaAst.StrLiteralNode))
+	env.declareFunc("inputTimeout", NewNativeFunc(self.nativeInputTimeout, scrilaAst.StrLiteralNode))
+	env.declareFunc("isDir", NewNativeFunc(self.nativeIsDir, scrilaAst.BoolLiteralNode))
+	env.declareFunc("isFile", NewNativeFunc(self.nativeIsFile, scrilaAst.BoolLiteralNode))
+	env.declareFunc("isRoot", NewNativeFunc(self.nativeIsRoot, scrilaAst.BoolLiteralNode))
+	env.declareFunc("jobResult", NewNativeFunc(self.nativeJobResult, scrilaAst.StrLiteralNode))
+	env.declareFunc("kill", NewNativeFunc(self.nativeKill, scrilaAst.VoidNode))
+	env.declareFunc("killProcess", NewNativeFunc(self.nativeKillProcess, scrilaAst.VoidNode))
+	env.declareFunc("lastExitCode", NewNativeFunc(self.nativeLastExitCode, scrilaAst.IntLiteralNode))
+	env.declareFunc("lines", NewNativeFunc(self.nativeLines, scrilaAst.StrArrayNode))
+	env.declareFunc("listDir", NewNativeFunc(self.nativeListDir, scrilaAst.StrArrayNode))
+	env.declareFunc("logDebug", NewNativeFunc(self.nativeLogDebug, scrilaAst.VoidNode))
+	env.declareFunc("logError", NewNativeFunc(self.nativeLogError, scrilaAst.VoidNode))
+	env.declareFunc("logInfo", NewNativeFunc(self.nativeLogInfo, scrilaAst.VoidNode))
+	env.declareFunc("logWarn", NewNativeFunc(self.nativeLogWarn, scrilaAst.VoidNode))
+	env.declareFunc("max", NewNativeFunc(self.nativeMax, scrilaAst.IntLiteralNode))
+	env.declareFunc("min", NewNativeFunc(self.nativeMin, scrilaAst.IntLiteralNode))
+	env.declareFunc("mkdir", NewNativeFunc(self.nativeMkdir, scrilaAst.VoidNode))
+	env.declareFunc("move", NewNativeFunc(self.nativeMove, scrilaAst.VoidNode))
+	env.declareFunc("onSignal", NewInlineNativeFunc(self.nativeOnSignal, self.inlineOnSignal, scrilaAst.VoidNode))
+	env.declareFunc("osName", NewNativeFunc(self.nativeOsName, scrilaAst.StrLiteralNode))
+	env.declareFunc("parallelExitCodes", NewNativeFunc(self.nativeParallelExitCodes, scrilaAst.IntArrayNode))
+	env.declareFunc("pathAbs", NewNativeFunc(self.nativePathAbs, scrilaAst.StrLiteralNode))
+	env.declareFunc("pathBase", NewNativeFunc(self.nativePathBase, scrilaAst.StrLiteralNode))
+	env.declareFunc("pathDir", NewNativeFunc(self.nativePathDir, scrilaAst.StrLiteralNode))
+	env.declareFunc("pathExt", NewNativeFunc(self.nativePathExt, scrilaAst.StrLiteralNode))
+	env.declareFunc("pathJoin", NewNativeFunc(self.nativePathJoin, scrilaAst.StrLiteralNode))
+	env.declareFunc("pathRel", NewNativeFunc(self.nativePathRel, scrilaAst.StrLiteralNode))
+	env.declareFunc("pathStem", NewNativeFunc(self.nativePathStem, scrilaAst.StrLiteralNode))
+	env.declareFunc("pid", NewNativeFunc(self.nativePid, scrilaAst.IntLiteralNode))
+	env.declareFunc("pipe", NewInlineNativeFunc(self.nativePipe, self.inlinePipe, scrilaAst.StrLiteralNode))
+	env.declareFunc("pipeAppendFile", NewInlineNativeFunc(self.nativePipeAppendFile, self.inlinePipeAppendFile, scrilaAst.VoidNode))
+	env.declareFunc("pipeFromFile", NewInlineNativeFunc(self.nativePipeFromFile, self.inlinePipeFromFile, scrilaAst.StrLiteralNode))
+	env.declareFunc("pipeRedirect", NewInlineNativeFunc(self.nativePipeRedirect, self.inlinePipeRedirect, scrilaAst.StrLiteralNode))
+	env.declareFunc("pipeToFile", NewInlineNativeFunc(self.nativePipeToFile, self.inlinePipeToFile, scrilaAst.VoidNode))
+	env.declareFunc("pipeWithStderr", NewInlineNativeFunc(self.nativePipeWithStderr, self.inlinePipeWithStderr, scrilaAst.StrLiteralNode))
+	env.declareFunc("pow", NewNativeFunc(self.nativePow, scrilaAst.IntLiteralNode))
+	env.declareFunc("ppid", NewNativeFunc(self.nativePpid, scrilaAst.IntLiteralNode))
 	env.declareFunc("print", NewNativeFunc(self.nativePrintLn, scrilaAst.VoidNode))
+	env.declareFunc("printErr", NewNativeFunc(self.nativePrintLn, scrilaAst.VoidNode))
+	env.declareFunc("printErrLn", NewNativeFunc(self.nativePrintLn, scrilaAst.VoidNode))
+	env.declareFunc("printF", NewNativeFunc(self.nativePrintF, scrilaAst.VoidNode))
 	env.declareFunc("printLn", NewNativeFunc(self.nativePrintLn, scrilaAst.VoidNode))
+	env.declareFunc("processRunning", NewNativeFunc(self.nativeProcessRunning, scrilaAst.BoolLiteralNode))
+	env.declareFunc("random", NewNativeFunc(self.nativeRandom, scrilaAst.IntLiteralNode))
+	env.declareFunc("randomSeed", NewNativeFunc(self.nativeRandomSeed, scrilaAst.VoidNode))
+	env.declareFunc("readAll", NewNativeFunc(self.nativeReadAll, scrilaAst.StrLiteralNode))
+	env.declareFunc("readFile", NewNativeFunc(self.nativeReadFile, scrilaAst.StrLiteralNode))
+	env.declareFunc("readLine", NewNativeFunc(self.nativeReadLine, scrilaAst.StrLiteralNode))
+	env.declareFunc("readLines", NewNativeFunc(self.nativeReadLines, scrilaAst.StrArrayNode))
+	env.declareFunc("reFind", NewNativeFunc(self.nativeReFind, scrilaAst.StrArrayNode))
+	env.declareFunc("reMatch", NewNativeFunc(self.nativeReMatch, scrilaAst.BoolLiteralNode))
+	env.declareFunc("remove", NewNativeFunc(self.nativeRemove, scrilaAst.VoidNode))
+	env.declareFunc("reReplace", NewNativeFunc(self.nativeReReplace, scrilaAst.StrLiteralNode))
+	env.declareFunc("retry", NewNativeFunc(self.nativeRetry, scrilaAst.BoolLiteralNode))
+	env.declareFunc("retryBackoff", NewNativeFunc(self.nativeRetryBackoff, scrilaAst.BoolLiteralNode))
+	env.declareFunc("round", NewNativeFunc(self.nativeRound, scrilaAst.IntLiteralNode))
+	env.declareFunc("run", NewNativeFunc(self.nativeRun, scrilaAst.StrLiteralNode))
+	env.declareFunc("setLogLevel", NewNativeFunc(self.nativeSetLogLevel, scrilaAst.VoidNode))
+	env.declareFunc("sign", NewNativeFunc(self.nativeSign, scrilaAst.IntLiteralNode))
 	env.declareFunc("sleep", NewNativeFunc(self.nativeSleep, scrilaAst.VoidNode))
+	env.declareFunc("spawn", NewInlineNativeFunc(self.nativeSpawn, self.inlineSpawn, scrilaAst.JobNode))
+	env.declareFunc("sqrt", NewNativeFunc(self.nativeSqrt, scrilaAst.IntLiteralNode))
+	env.declareFunc("stdinIsTerminal", NewNativeFunc(self.nativeStdinIsTerminal, scrilaAst.BoolLiteralNode))
 	env.declareFunc("strContains", NewNativeFunc(self.nativeStrContains, scrilaAst.BoolLiteralNode))
+	env.declareFunc("strCount", NewNativeFunc(self.nativeStrCount, scrilaAst.IntLiteralNode))
 	env.declareFunc("strEndsWith", NewNativeFunc(self.nativeStrEndsWith, scrilaAst.BoolLiteralNode))
+	env.declareFunc("strIndexOf", NewNativeFunc(self.nativeStrIndexOf, scrilaAst.IntLiteralNode))
 	env.declareFunc("strIsBool", NewNativeFunc(self.nativeStrIsBool, scrilaAst.BoolLiteralNode))
 	env.declareFunc("strIsInt", NewNativeFunc(self.nativeStrIsInt, scrilaAst.BoolLiteralNode))
+	env.declareFunc("strJoin", NewNativeFunc(self.nativeStrJoin, scrilaAst.StrLiteralNode))
+	env.declareFunc("strLastIndexOf", NewNativeFunc(self.nativeStrLastIndexOf, scrilaAst.IntLiteralNode))
+	env.declareFunc("strLen", NewNativeFunc(self.nativeStrLen, scrilaAst.IntLiteralNode))
+	env.declareFunc("strPadLeft", NewNativeFunc(self.nativeStrPadLeft, scrilaAst.StrLiteralNode))
+	env.declareFunc("strPadRight", NewNativeFunc(self.nativeStrPadRight, scrilaAst.StrLiteralNode))
+	env.declareFunc("strRepeat", NewNativeFunc(self.nativeStrRepeat, scrilaAst.StrLiteralNode))
+	env.declareFunc("strReplace", NewNativeFunc(self.nativeStrReplace, scrilaAst.StrLiteralNode))
+	env.declareFunc("strReplaceAll", NewNativeFunc(self.nativeStrReplaceAll, scrilaAst.StrLiteralNode))
+	env.declareFunc("strReverse", NewNativeFunc(self.nativeStrReverse, scrilaAst.StrLiteralNode))
 	env.declareFunc("strSplit", NewNativeFunc(self.nativeStrSplit, scrilaAst.StrArrayNode))
 	env.declareFunc("strStartsWith", NewNativeFunc(self.nativeStrStartsWith, scrilaAst.BoolLiteralNode))
+	env.declareFunc("strSub", NewNativeFunc(self.nativeStrSub, scrilaAst.StrLiteralNode))
 	env.declareFunc("strToBool", NewNativeFunc(self.nativeStrToBool, scrilaAst.BoolLiteralNode))
+	env.declareFunc("strToFloat", NewNativeFunc(self.nativeStrToFloat, scrilaAst.FloatLiteralNode))
 	env.declareFunc("strToInt", NewNativeFunc(self.nativeStrToInt, scrilaAst.IntLiteralNode))
+	env.declareFunc("strToLower", NewNativeFunc(self.nativeStrToLower, scrilaAst.StrLiteralNode))
+	env.declareFunc("strToUpper", NewNativeFunc(self.nativeStrToUpper, scrilaAst.StrLiteralNode))
+	env.declareFunc("strTrim", NewNativeFunc(self.nativeStrTrim, scrilaAst.StrLiteralNode))
+	env.declareFunc("strTrimLeft", NewNativeFunc(self.nativeStrTrimLeft, scrilaAst.StrLiteralNode))
+	env.declareFunc("strTrimRight", NewNativeFunc(self.nativeStrTrimRight, scrilaAst.StrLiteralNode))
+	env.declareFunc("wait", NewNativeFunc(self.nativeWait, scrilaAst.IntLiteralNode))
+	env.declareFunc("waitAll", NewNativeFunc(self.nativeWaitAll, scrilaAst.VoidNode))
+	env.declareFunc("walk", NewNativeFunc(self.nativeWalk, scrilaAst.StrArrayNode))
+	env.declareFunc("whoami", NewNativeFunc(self.nativeWhoami, scrilaAst.StrLiteralNode))
+	env.declareFunc("writeFile", NewNativeFunc(self.nativeWriteFile, scrilaAst.VoidNode))
+}
+
+// Bash function names of native functions whose name collides with a Bash builtin or command.
+// Otherwise the command would be shadowed for the whole script e.g. "exec("mkdir -p /x")" would call the native function.
+var nativeBashFuncNames = map[string]string{
+	"copy":     "scrila_copy",
+	"hostname": "scrila_hostname",
+	"kill":     "scrila_kill",
+	"mkdir":    "scrila_mkdir",
+	"move":     "scrila_move",
+	"remove":   "scrila_remove",
+	"wait":     "scrila_wait",
+	"whoami":   "scrila_whoami",
+}
+
+// Returns the name of the Bash function that implements the given native function
+func nativeBashFuncName(funcName string) string {
+	if bashName, ok := nativeBashFuncNames[funcName]; ok {
+		return bashName
+	}
+	return funcName
+}
+
+// Adds the bash function "nativeError" to the native body.
+// It is used by native functions to print an error message and to exit the script if an operation fails.
+func (self *Transpiler) appendNativeErrorFunc() {
+	if slices.Contains(self.usedNativeFunctions, "nativeError") {
+		return
+	}
+	self.usedNativeFunctions = append(self.usedNativeFunctions, "nativeError")
+	funcDecl := bashAst.NewFuncDeclaration("nativeError", bashAst.VoidNode)
+	funcDecl.AppendParams(bashAst.NewFuncParameter("message", bashAst.StrLiteralNode))
+	funcDecl.AppendBody(bashAst.NewBashStmt("echo \"ScriLa error: ${message}\" >&2"))
+	funcDecl.AppendBody(bashAst.NewBashStmt("exit 1"))
+	self.bashProgram.AppendNativeBody(funcDecl)
 }
 
 // MARK: exec
@@ -47,12 +210,20 @@ func (self *Transpiler) nativeExec(args []scrilaAst.IExpr, env *Environment) (sc
 		funcDecl := bashAst.NewFuncDeclaration("exec", bashAst.StrLiteralNode)
 		funcDecl.AppendParams(bashAst.NewFuncParameter("command", bashAst.StrLiteralNode))
 		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=$(eval ${command})"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("execExitCode=$?"))
 		self.bashProgram.AppendNativeBody(funcDecl)
 	}
 
 	return NewStrVal("str"), nil
 }
 
+// MARK: execLines
+func (self *Transpiler) nativeExecLines(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+	// The lines are streamed by evalForLinesStatement
+	return NewNullVal(), fmt.Errorf("execLines() can only be used in a for loop e.g. 'for (str line in execLines(command))'")
+}
+
 // MARK: exit
 func (self *Transpiler) nativeExit(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
 	self.printFuncName("")
@@ -0,0 +1,690 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// Validates that the given arg is a typed array and returns the data type of the array
+func (self *Transpiler) validateArrayArg(funcName string, paramName string, arg scrilaAst.IExpr, env *Environment) (scrilaAst.NodeType, error) {
+	dataType, isArray, err := self.exprArrayDataType(arg, env)
+	if err != nil {
+		return "", err
+	}
+	if !isArray {
+		_, givenType, err := self.exprIsType(arg, scrilaAst.VoidNode, env)
+		if err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("%s() - Parameter %s must be a typed array or a variable of type array. Got '%s'", funcName, paramName, givenType)
+	}
+	return dataType, nil
+}
+
+// Validates that the given arg has the data type of the array
+func (self *Transpiler) validateArrayElementArg(funcName string, paramName string, arg scrilaAst.IExpr, dataType scrilaAst.NodeType, env *Environment) error {
+	doMatch, givenType, err := self.exprIsType(arg, dataType, env)
+	if err != nil {
+		return err
+	}
+	if !doMatch {
+		return fmt.Errorf("%s() - Parameter %s must be of the array data type '%s'. Got '%s'", funcName, paramName, dataType, givenType)
+	}
+	return nil
+}
+
+// Return type for native functions that return a modified copy of the array passed as first arg
+func (self *Transpiler) firstArgArrayType(args []scrilaAst.IExpr, env *Environment) (scrilaAst.NodeType, error) {
+	if len(args) == 0 {
+		return "", fmt.Errorf("firstArgArrayType(): No args given")
+	}
+	dataType, isArray, err := self.exprArrayDataType(args[0], env)
+	if err != nil {
+		return "", err
+	}
+	if !isArray {
+		return "", fmt.Errorf("firstArgArrayType(): First arg of kind '%s' is not a typed array", args[0].GetKind())
+	}
+	return scrilaAst.DataTypeToArrayType(dataType)
+}
+
+// Return type for native functions that return an array of the return type of the callback passed as second arg
+func (self *Transpiler) callbackArrayType(args []scrilaAst.IExpr, env *Environment) (scrilaAst.NodeType, error) {
+	if len(args) < 2 || !self.exprIsFuncRef(args[1], env) {
+		return "", fmt.Errorf("callbackArrayType(): Second arg is not a function")
+	}
+	fn, err := env.lookupFunc(identNodeGetSymbol(args[1]))
+	if err != nil {
+		return "", err
+	}
+	return scrilaAst.DataTypeToArrayType(runtimeToFuncVal(fn).GetReturnType())
+}
+
+// Return type for native functions that return a value of the type of the second arg
+func (self *Transpiler) secondArgType(args []scrilaAst.IExpr, env *Environment) (scrilaAst.NodeType, error) {
+	if len(args) < 2 {
+		return "", fmt.Errorf("secondArgType(): Not enough args given")
+	}
+	_, givenType, err := self.exprIsType(args[1], scrilaAst.VoidNode, env)
+	if err != nil {
+		return "", err
+	}
+	return givenType, nil
+}
+
+// Validates that the given arg references a user defined function with the given parameter types and returns the function
+func (self *Transpiler) validateCallbackArg(funcName string, paramName string, arg scrilaAst.IExpr, paramTypes []scrilaAst.NodeType, env *Environment) (IFunctionVal, error) {
+	if !self.exprIsFuncRef(arg, env) {
+		_, givenType, err := self.exprIsType(arg, scrilaAst.VoidNode, env)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s() - Parameter %s must be a user defined function. Got '%s'", funcName, paramName, givenType)
+	}
+	caller, err := env.lookupFunc(identNodeGetSymbol(arg))
+	if err != nil {
+		return nil, err
+	}
+	fn := runtimeToFuncVal(caller)
+
+	if len(fn.GetParams()) != len(paramTypes) {
+		return nil, fmt.Errorf("%s() - Function '%s' must have %d parameter(s). Got %d", funcName, fn.GetName(), len(paramTypes), len(fn.GetParams()))
+	}
+	for i, param := range fn.GetParams() {
+		if param.GetParamType() != paramTypes[i] {
+			return nil, fmt.Errorf("%s() - Parameter '%s' of function '%s' must be of type '%s'. Got '%s'", funcName, param.GetName(), fn.GetName(), paramTypes[i], param.GetParamType())
+		}
+	}
+	return fn, nil
+}
+
+// Validates that the given callback returns a value of the wanted type
+func validateCallbackReturnType(funcName string, fn IFunctionVal, wantedType scrilaAst.NodeType) error {
+	if fn.GetReturnType() != wantedType {
+		return fmt.Errorf("%s() - Function '%s' must return '%s'. Got '%s'", funcName, fn.GetName(), wantedType, fn.GetReturnType())
+	}
+	return nil
+}
+
+// Creates the Bash function for a native function that returns an array.
+// The caller passes the name of the array the result is written to (e.g. tmpInts) as first argument.
+func newArrayResultFuncDeclaration(funcName string) *bashAst.FuncDeclaration {
+	funcDecl := bashAst.NewFuncDeclaration(funcName, bashAst.ArrayLiteralNode)
+	funcDecl.AppendParams(bashAst.NewFuncParameter("resultVar", bashAst.StrLiteralNode))
+	funcDecl.AppendParams(bashAst.NewFuncParameter("values", bashAst.ArrayLiteralNode))
+	return funcDecl
+}
+
+// MARK: arrAll
+func (self *Transpiler) nativeArrAll(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrAll(array values, func fn)")
+	}
+	dataType, err := self.validateArrayArg("arrAll", "values", args[0], env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	fn, err := self.validateCallbackArg("arrAll", "fn", args[1], []scrilaAst.NodeType{dataType}, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if err = validateCallbackReturnType("arrAll", fn, scrilaAst.BoolLiteralNode); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for arrAll to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrAll") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrAll")
+		funcDecl := bashAst.NewFuncDeclaration("arrAll", bashAst.BoolLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("values", bashAst.ArrayLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("fn", bashAst.StrLiteralNode))
+		appendCallbackLoop(funcDecl, "\"${fn}\" \"${value}\"",
+			"if [[ \"${tmpBools[${tmpIndex}]}\" != \"true\" ]]",
+			"then",
+			"\ttmpIndex=${index}",
+			"\ttmpBools[${tmpIndex}]=\"false\"",
+			"\treturn",
+			"fi",
+		)
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpBools[${tmpIndex}]=\"true\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: arrAny
+func (self *Transpiler) nativeArrAny(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrAny(array values, func fn)")
+	}
+	dataType, err := self.validateArrayArg("arrAny", "values", args[0], env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	fn, err := self.validateCallbackArg("arrAny", "fn", args[1], []scrilaAst.NodeType{dataType}, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if err = validateCallbackReturnType("arrAny", fn, scrilaAst.BoolLiteralNode); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for arrAny to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrAny") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrAny")
+		funcDecl := bashAst.NewFuncDeclaration("arrAny", bashAst.BoolLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("values", bashAst.ArrayLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("fn", bashAst.StrLiteralNode))
+		appendCallbackLoop(funcDecl, "\"${fn}\" \"${value}\"",
+			"if [[ \"${tmpBools[${tmpIndex}]}\" == \"true\" ]]",
+			"then",
+			"\ttmpIndex=${index}",
+			"\ttmpBools[${tmpIndex}]=\"true\"",
+			"\treturn",
+			"fi",
+		)
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpBools[${tmpIndex}]=\"false\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: arrContains
+func (self *Transpiler) nativeArrContains(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrContains(array values, value)")
+	}
+	dataType, err := self.validateArrayArg("arrContains", "values", args[0], env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if err = self.validateArrayElementArg("arrContains", "value", args[1], dataType, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for arrContains to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrContains") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrContains")
+		funcDecl := bashAst.NewFuncDeclaration("arrContains", bashAst.BoolLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("values", bashAst.ArrayLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpBools[${tmpIndex}]=\"false\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local element"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("for element in \"${values[@]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ \"${element}\" == \"${value}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\ttmpBools[${tmpIndex}]=\"true\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tbreak"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: arrFilter
+func (self *Transpiler) nativeArrFilter(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrFilter(array values, func fn)")
+	}
+	dataType, err := self.validateArrayArg("arrFilter", "values", args[0], env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	fn, err := self.validateCallbackArg("arrFilter", "fn", args[1], []scrilaAst.NodeType{dataType}, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if err = validateCallbackReturnType("arrFilter", fn, scrilaAst.BoolLiteralNode); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for arrFilter to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrFilter") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrFilter")
+		funcDecl := newArrayResultFuncDeclaration("arrFilter")
+		funcDecl.AppendParams(bashAst.NewFuncParameter("fn", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local -n result=${resultVar}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local filtered=()"))
+		appendCallbackLoop(funcDecl, "\"${fn}\" \"${value}\"",
+			"if [[ \"${tmpBools[${tmpIndex}]}\" == \"true\" ]]",
+			"then",
+			"\tfiltered+=(\"${value}\")",
+			"fi",
+		)
+		funcDecl.AppendBody(bashAst.NewBashStmt("result=(\"${filtered[@]}\")"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return self.arrayResultVal(args, env)
+}
+
+// MARK: arrIndexOf
+func (self *Transpiler) nativeArrIndexOf(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrIndexOf(array values, value)")
+	}
+	dataType, err := self.validateArrayArg("arrIndexOf", "values", args[0], env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if err = self.validateArrayElementArg("arrIndexOf", "value", args[1], dataType, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for arrIndexOf to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrIndexOf") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrIndexOf")
+		funcDecl := bashAst.NewFuncDeclaration("arrIndexOf", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("values", bashAst.ArrayLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=-1"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local i"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("for i in \"${!values[@]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ \"${values[i]}\" == \"${value}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\ttmpInts[${tmpIndex}]=${i}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tbreak"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: arrInsert
+func (self *Transpiler) nativeArrInsert(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 3 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrInsert(array values, int index, value)")
+	}
+	dataType, err := self.validateArrayArg("arrInsert", "values", args[0], env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	doMatch, givenType, err := self.exprIsType(args[1], scrilaAst.IntLiteralNode, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if !doMatch {
+		return NewNullVal(), fmt.Errorf("arrInsert() - Parameter index must be an int or a variable of type int. Got '%s'", givenType)
+	}
+	if err = self.validateArrayElementArg("arrInsert", "value", args[2], dataType, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for arrInsert to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrInsert") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrInsert")
+		funcDecl := newArrayResultFuncDeclaration("arrInsert")
+		funcDecl.AppendParams(bashAst.NewFuncParameter("index", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local -n result=${resultVar}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("result=(\"${values[@]:0:${index}}\" \"${value}\" \"${values[@]:${index}}\")"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return self.arrayResultVal(args, env)
+}
+
+// MARK: arrMap
+func (self *Transpiler) nativeArrMap(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrMap(array values, func fn)")
+	}
+	dataType, err := self.validateArrayArg("arrMap", "values", args[0], env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	fn, err := self.validateCallbackArg("arrMap", "fn", args[1], []scrilaAst.NodeType{dataType}, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if !slices.Contains([]scrilaAst.NodeType{scrilaAst.BoolLiteralNode, scrilaAst.IntLiteralNode, scrilaAst.StrLiteralNode}, fn.GetReturnType()) {
+		return NewNullVal(), fmt.Errorf("arrMap() - Function '%s' must return a bool, int or str. Got '%s'", fn.GetName(), fn.GetReturnType())
+	}
+
+	// Add bash code for arrMap to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrMap") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrMap")
+		// The callback returns its value in the same temporary array that receives the result
+		funcDecl := newArrayResultFuncDeclaration("arrMap")
+		funcDecl.AppendParams(bashAst.NewFuncParameter("fn", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local -n result=${resultVar}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local mapped=()"))
+		appendCallbackLoop(funcDecl, "\"${fn}\" \"${value}\"",
+			"mapped+=(\"${result[${tmpIndex}]}\")",
+		)
+		funcDecl.AppendBody(bashAst.NewBashStmt("result=(\"${mapped[@]}\")"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+
+	arrayType, err := scrilaAst.DataTypeToArrayType(fn.GetReturnType())
+	if err != nil {
+		return NewNullVal(), err
+	}
+	return scrilaNodeTypeToRuntimeVal(arrayType)
+}
+
+// MARK: arrPop
+func (self *Transpiler) nativeArrPop(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrPop(array values)")
+	}
+	if _, err := self.validateArrayArg("arrPop", "values", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for arrPop to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrPop") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrPop")
+		funcDecl := newArrayResultFuncDeclaration("arrPop")
+		funcDecl.AppendBody(bashAst.NewBashStmt("local -n result=${resultVar}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${#values[@]} -gt 0 ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tunset 'values[-1]'"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("result=(\"${values[@]}\")"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return self.arrayResultVal(args, env)
+}
+
+// MARK: arrPush
+func (self *Transpiler) nativeArrPush(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrPush(array values, value)")
+	}
+	dataType, err := self.validateArrayArg("arrPush", "values", args[0], env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if err = self.validateArrayElementArg("arrPush", "value", args[1], dataType, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for arrPush to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrPush") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrPush")
+		funcDecl := newArrayResultFuncDeclaration("arrPush")
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local -n result=${resultVar}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("result=(\"${values[@]}\" \"${value}\")"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return self.arrayResultVal(args, env)
+}
+
+// MARK: arrReduce
+func (self *Transpiler) nativeArrReduce(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 3 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrReduce(array values, init, func fn)")
+	}
+	dataType, err := self.validateArrayArg("arrReduce", "values", args[0], env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	_, initType, err := self.exprIsType(args[1], scrilaAst.VoidNode, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if !slices.Contains([]scrilaAst.NodeType{scrilaAst.BoolLiteralNode, scrilaAst.IntLiteralNode, scrilaAst.StrLiteralNode}, initType) {
+		return NewNullVal(), fmt.Errorf("arrReduce() - Parameter init must be a bool, int or str. Got '%s'", initType)
+	}
+	fn, err := self.validateCallbackArg("arrReduce", "fn", args[2], []scrilaAst.NodeType{initType, dataType}, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if err = validateCallbackReturnType("arrReduce", fn, initType); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for arrReduce to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrReduce") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrReduce")
+		// The callback returns the accumulator in the same temporary array that receives the result
+		funcDecl := bashAst.NewFuncDeclaration("arrReduce", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("resultVar", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("values", bashAst.ArrayLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("init", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("fn", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local -n result=${resultVar}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local acc=\"${init}\""))
+		appendCallbackLoop(funcDecl, "\"${fn}\" \"${acc}\" \"${value}\"",
+			"acc=\"${result[${tmpIndex}]}\"",
+		)
+		funcDecl.AppendBody(bashAst.NewBashStmt("result[${tmpIndex}]=\"${acc}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return scrilaNodeTypeToRuntimeVal(initType)
+}
+
+// MARK: arrRemoveAt
+func (self *Transpiler) nativeArrRemoveAt(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrRemoveAt(array values, int index)")
+	}
+	if _, err := self.validateArrayArg("arrRemoveAt", "values", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+	doMatch, givenType, err := self.exprIsType(args[1], scrilaAst.IntLiteralNode, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if !doMatch {
+		return NewNullVal(), fmt.Errorf("arrRemoveAt() - Parameter index must be an int or a variable of type int. Got '%s'", givenType)
+	}
+
+	// Add bash code for arrRemoveAt to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrRemoveAt") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrRemoveAt")
+		funcDecl := newArrayResultFuncDeclaration("arrRemoveAt")
+		funcDecl.AppendParams(bashAst.NewFuncParameter("index", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local -n result=${resultVar}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("result=(\"${values[@]:0:${index}}\" \"${values[@]:$((${index} + 1))}\")"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return self.arrayResultVal(args, env)
+}
+
+// MARK: arrReverse
+func (self *Transpiler) nativeArrReverse(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrReverse(array values)")
+	}
+	if _, err := self.validateArrayArg("arrReverse", "values", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for arrReverse to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrReverse") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrReverse")
+		funcDecl := newArrayResultFuncDeclaration("arrReverse")
+		funcDecl.AppendBody(bashAst.NewBashStmt("local -n result=${resultVar}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("result=()"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local i"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("for (( i=${#values[@]}-1; i>=0; i-- ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tresult+=(\"${values[i]}\")"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return self.arrayResultVal(args, env)
+}
+
+// MARK: arrSort
+func (self *Transpiler) nativeArrSort(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrSort(array values)")
+	}
+	if _, err := self.validateArrayArg("arrSort", "values", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for arrSort to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrSort") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrSort")
+		// Int and float arrays are written to "tmpInts" and "tmpFloats" and sorted numerically. All other arrays are sorted lexically.
+		funcDecl := newArrayResultFuncDeclaration("arrSort")
+		funcDecl.AppendBody(bashAst.NewBashStmt("local -n result=${resultVar}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("result=()"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${#values[@]} -eq 0 ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\treturn"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ \"${resultVar}\" == \"tmpInts\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tmapfile -t result < <(printf '%s\\n' \"${values[@]}\" | sort -n)"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("elif [[ \"${resultVar}\" == \"tmpFloats\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tmapfile -t result < <(printf '%s\\n' \"${values[@]}\" | LC_ALL=C sort -g)"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tmapfile -t result < <(printf '%s\\n' \"${values[@]}\" | LC_ALL=C sort)"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return self.arrayResultVal(args, env)
+}
+
+// MARK: arrUnique
+func (self *Transpiler) nativeArrUnique(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: arrUnique(array values)")
+	}
+	if _, err := self.validateArrayArg("arrUnique", "values", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for arrUnique to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "arrUnique") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "arrUnique")
+		funcDecl := newArrayResultFuncDeclaration("arrUnique")
+		funcDecl.AppendBody(bashAst.NewBashStmt("local -n result=${resultVar}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("result=()"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local -A seen=()"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local value"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("for value in \"${values[@]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ -z \"${seen[\"_${value}\"]}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tseen[\"_${value}\"]=1"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tresult+=(\"${value}\")"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return self.arrayResultVal(args, env)
+}
+
+// MARK: strJoin
+func (self *Transpiler) nativeStrJoin(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strJoin(str[] parts, str separator)")
+	}
+	isArray, err := self.exprIsArray(args[0], scrilaAst.StrLiteralNode, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if !isArray {
+		_, givenType, err := self.exprIsType(args[0], scrilaAst.VoidNode, env)
+		if err != nil {
+			return NewNullVal(), err
+		}
+		return NewNullVal(), fmt.Errorf("strJoin() - Parameter parts must be a string array or a variable of type string array. Got '%s'", givenType)
+	}
+	doMatch, givenType, err := self.exprIsType(args[1], scrilaAst.StrLiteralNode, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if !doMatch {
+		return NewNullVal(), fmt.Errorf("strJoin() - Parameter separator must be a string or a variable of type string. Got '%s'", givenType)
+	}
+
+	// Add bash code for strJoin to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strJoin") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strJoin")
+		funcDecl := bashAst.NewFuncDeclaration("strJoin", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("parts", bashAst.StrArrayNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("separator", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local joined=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local part"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("for part in \"${parts[@]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tjoined+=\"${separator}${part}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${joined:${#separator}}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// Returns the runtime value for the array type of the first arg
+func (self *Transpiler) arrayResultVal(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	arrayType, err := self.firstArgArrayType(args, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	return scrilaNodeTypeToRuntimeVal(arrayType)
+}
+
+// Appends a loop calling the callback for each value of the array "values" followed by the given statements.
+// The callback can change "tmpIndex" so it is restored after the loop.
+func appendCallbackLoop(funcDecl *bashAst.FuncDeclaration, callback string, stmts ...string) {
+	funcDecl.AppendBody(bashAst.NewBashStmt("local index=${tmpIndex}"))
+	funcDecl.AppendBody(bashAst.NewBashStmt("local value"))
+	funcDecl.AppendBody(bashAst.NewBashStmt("for value in \"${values[@]}\""))
+	funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+	funcDecl.AppendBody(bashAst.NewBashStmt("\t" + callback))
+	for _, stmt := range stmts {
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t" + stmt))
+	}
+	funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+	funcDecl.AppendBody(bashAst.NewBashStmt("tmpIndex=${index}"))
+}
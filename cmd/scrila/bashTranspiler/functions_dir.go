@@ -0,0 +1,96 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// MARK: glob
+func (self *Transpiler) nativeGlob(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: glob(str pattern)")
+	}
+	if err := self.validateArgType("glob", "pattern", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for glob to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "glob") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "glob")
+		funcDecl := bashAst.NewFuncDeclaration("glob", bashAst.StrArrayNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("pattern", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local restoreNullglob"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("restoreNullglob=$(shopt -p nullglob)"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("shopt -s nullglob"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("# The pattern is not quoted to be expanded. An empty IFS prevents word splitting."))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local IFS=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs=(${pattern})"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("eval \"${restoreNullglob}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewArrayVal(scrilaAst.StrArrayValueType), nil
+}
+
+// MARK: listDir
+func (self *Transpiler) nativeListDir(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: listDir(str path)")
+	}
+	if err := self.validateArgType("listDir", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for listDir to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "listDir") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "listDir")
+		funcDecl := bashAst.NewFuncDeclaration("listDir", bashAst.StrArrayNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("[[ -d \"${path}\" ]] || nativeError \"listDir() - Directory '${path}' does not exist\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local restoreNullglob restoreDotglob"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("restoreNullglob=$(shopt -p nullglob)"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("restoreDotglob=$(shopt -p dotglob)"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("shopt -s nullglob dotglob"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local entries=(\"${path%/}\"/*)"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("eval \"${restoreNullglob}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("eval \"${restoreDotglob}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs=(\"${entries[@]##*/}\")"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewArrayVal(scrilaAst.StrArrayValueType), nil
+}
+
+// MARK: walk
+func (self *Transpiler) nativeWalk(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: walk(str root)")
+	}
+	if err := self.validateArgType("walk", "root", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for walk to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "walk") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "walk")
+		funcDecl := bashAst.NewFuncDeclaration("walk", bashAst.StrArrayNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("root", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("[[ -d \"${root}\" ]] || nativeError \"walk() - Directory '${root}' does not exist\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("# Null-terminated file names are safe for names with spaces and newlines"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("mapfile -t -d '' tmpStrs < <(find \"${root}\" -type f -print0 | sort -z)"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewArrayVal(scrilaAst.StrArrayValueType), nil
+}
@@ -0,0 +1,195 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+	"regexp"
+
+	"golang.org/x/exp/slices"
+)
+
+var envNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Validates the name of an environment variable if it is given as string literal.
+// The name must not be used for a ScriLa variable as it would change the environment variable.
+func (self *Transpiler) validateEnvNameArg(funcName string, arg scrilaAst.IExpr) error {
+	if arg.GetKind() != scrilaAst.StrLiteralNode {
+		return nil
+	}
+	name := scrilaAst.ExprToStrLit(arg).GetValue()
+	if !envNameRegex.MatchString(name) {
+		return fmt.Errorf("%s() - Invalid environment variable name '%s'", funcName, name)
+	}
+	if slices.Contains(self.varNames, name) {
+		return fmt.Errorf("%s() - The environment variable '%s' has the same name as a variable of the script", funcName, name)
+	}
+	if !slices.Contains(self.envVarNames, name) {
+		self.envVarNames = append(self.envVarNames, name)
+	}
+	return nil
+}
+
+// Creates the declaration of an environment native function.
+// The parameter names are prefixed with "envVar" as local variables would hide environment variables with the same name.
+// The name is validated at runtime because it is used for an indirect expansion.
+func (self *Transpiler) newEnvFuncDeclaration(funcName string, returnType bashAst.NodeType, params ...string) bashAst.IFuncDeclaration {
+	self.appendNativeErrorFunc()
+	funcDecl := bashAst.NewFuncDeclaration(funcName, returnType)
+	funcDecl.AppendParams(bashAst.NewFuncParameter("envVarName", bashAst.StrLiteralNode))
+	for _, param := range params {
+		funcDecl.AppendParams(bashAst.NewFuncParameter(param, bashAst.StrLiteralNode))
+	}
+	funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ! ${envVarName} =~ ^[a-zA-Z_][a-zA-Z0-9_]*$ ]]"))
+	funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+	funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("\tnativeError \"%s() - Invalid environment variable name '${envVarName}'\"", funcName)))
+	funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+	return funcDecl
+}
+
+// MARK: envGet
+func (self *Transpiler) nativeEnvGet(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: envGet(str name)")
+	}
+	if err := self.validateArgType("envGet", "name", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateEnvNameArg("envGet", args[0]); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for envGet to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "envGet") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "envGet")
+		// Only exported variables are returned so that ScriLa variables are not mistaken for environment variables
+		funcDecl := self.newEnvFuncDeclaration("envGet", bashAst.StrLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${!envVarName@a} == *x* ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpStrs[${tmpIndex}]=\"${!envVarName}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpStrs[${tmpIndex}]=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: envGetOr
+func (self *Transpiler) nativeEnvGetOr(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: envGetOr(str name, str default)")
+	}
+	if err := self.validateArgType("envGetOr", "name", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("envGetOr", "default", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateEnvNameArg("envGetOr", args[0]); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for envGetOr to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "envGetOr") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "envGetOr")
+		funcDecl := self.newEnvFuncDeclaration("envGetOr", bashAst.StrLiteralNode, "envVarDefault")
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -v ${envVarName} && ${!envVarName@a} == *x* ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpStrs[${tmpIndex}]=\"${!envVarName}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpStrs[${tmpIndex}]=\"${envVarDefault}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: envHas
+func (self *Transpiler) nativeEnvHas(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: envHas(str name)")
+	}
+	if err := self.validateArgType("envHas", "name", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateEnvNameArg("envHas", args[0]); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for envHas to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "envHas") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "envHas")
+		funcDecl := self.newEnvFuncDeclaration("envHas", bashAst.BoolLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -v ${envVarName} && ${!envVarName@a} == *x* ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"true\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"false\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: envSet
+func (self *Transpiler) nativeEnvSet(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: envSet(str name, str value)")
+	}
+	if err := self.validateArgType("envSet", "name", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("envSet", "value", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateEnvNameArg("envSet", args[0]); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for envSet to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "envSet") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "envSet")
+		funcDecl := self.newEnvFuncDeclaration("envSet", bashAst.VoidNode, "envVarValue")
+		funcDecl.AppendBody(bashAst.NewBashStmt("export \"${envVarName}=${envVarValue}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
+
+// MARK: envUnset
+func (self *Transpiler) nativeEnvUnset(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: envUnset(str name)")
+	}
+	if err := self.validateArgType("envUnset", "name", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateEnvNameArg("envUnset", args[0]); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for envUnset to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "envUnset") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "envUnset")
+		funcDecl := self.newEnvFuncDeclaration("envUnset", bashAst.VoidNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("unset -v \"${envVarName}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
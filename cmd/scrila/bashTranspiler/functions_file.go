@@ -0,0 +1,372 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// MARK: appendFile
+func (self *Transpiler) nativeAppendFile(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: appendFile(str path, str content)")
+	}
+	if err := self.validateArgType("appendFile", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("appendFile", "content", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for appendFile to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "appendFile") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "appendFile")
+		funcDecl := bashAst.NewFuncDeclaration("appendFile", bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("content", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("printf '%s' \"${content}\" >> \"${path}\" || nativeError \"appendFile() - Cannot append to file '${path}'\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
+
+// MARK: copy
+func (self *Transpiler) nativeCopy(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: copy(str source, str destination)")
+	}
+	if err := self.validateArgType("copy", "source", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("copy", "destination", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for copy to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "copy") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "copy")
+		funcDecl := bashAst.NewFuncDeclaration(nativeBashFuncName("copy"), bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("source", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("destination", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("cp -R -- \"${source}\" \"${destination}\" || nativeError \"copy() - Cannot copy '${source}' to '${destination}'\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
+
+// MARK: dirExists
+func (self *Transpiler) nativeDirExists(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: dirExists(str path)")
+	}
+	if err := self.validateArgType("dirExists", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for dirExists to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "dirExists") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "dirExists")
+		funcDecl := bashAst.NewFuncDeclaration("dirExists", bashAst.BoolLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -d \"${path}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"true\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"false\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: fileExists
+func (self *Transpiler) nativeFileExists(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: fileExists(str path)")
+	}
+	if err := self.validateArgType("fileExists", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for fileExists to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "fileExists") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "fileExists")
+		funcDecl := bashAst.NewFuncDeclaration("fileExists", bashAst.BoolLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -e \"${path}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"true\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"false\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: fileSize
+func (self *Transpiler) nativeFileSize(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: fileSize(str path)")
+	}
+	if err := self.validateArgType("fileSize", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for fileSize to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "fileSize") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "fileSize")
+		funcDecl := bashAst.NewFuncDeclaration("fileSize", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local size"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("size=$(wc -c < \"${path}\") || nativeError \"fileSize() - Cannot read file '${path}'\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=$((size))"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: isDir
+func (self *Transpiler) nativeIsDir(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: isDir(str path)")
+	}
+	if err := self.validateArgType("isDir", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for isDir to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "isDir") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "isDir")
+		funcDecl := bashAst.NewFuncDeclaration("isDir", bashAst.BoolLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -d \"${path}\" && ! -L \"${path}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"true\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"false\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: isFile
+func (self *Transpiler) nativeIsFile(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: isFile(str path)")
+	}
+	if err := self.validateArgType("isFile", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for isFile to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "isFile") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "isFile")
+		funcDecl := bashAst.NewFuncDeclaration("isFile", bashAst.BoolLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -f \"${path}\" && ! -L \"${path}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"true\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"false\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: lines
+func (self *Transpiler) nativeLines(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+	// The lines are streamed by evalForLinesStatement
+	return NewNullVal(), fmt.Errorf("lines() can only be used in a for loop e.g. 'for (str line in lines(file))'")
+}
+
+// MARK: mkdir
+func (self *Transpiler) nativeMkdir(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: mkdir(str path, bool parents)")
+	}
+	if err := self.validateArgType("mkdir", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("mkdir", "parents", args[1], scrilaAst.BoolLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for mkdir to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "mkdir") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "mkdir")
+		// "command" calls the mkdir program even if a function with the same name is defined (e.g. when the script is sourced)
+		funcDecl := bashAst.NewFuncDeclaration(nativeBashFuncName("mkdir"), bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("parents", bashAst.BoolLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ \"${parents}\" == \"true\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tcommand mkdir -p -- \"${path}\" || nativeError \"mkdir() - Cannot create directory '${path}'\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tcommand mkdir -- \"${path}\" || nativeError \"mkdir() - Cannot create directory '${path}'\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
+
+// MARK: move
+func (self *Transpiler) nativeMove(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: move(str source, str destination)")
+	}
+	if err := self.validateArgType("move", "source", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("move", "destination", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for move to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "move") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "move")
+		funcDecl := bashAst.NewFuncDeclaration(nativeBashFuncName("move"), bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("source", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("destination", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("mv -- \"${source}\" \"${destination}\" || nativeError \"move() - Cannot move '${source}' to '${destination}'\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
+
+// MARK: readFile
+func (self *Transpiler) nativeReadFile(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: readFile(str path)")
+	}
+	if err := self.validateArgType("readFile", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for readFile to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "readFile") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "readFile")
+		funcDecl := bashAst.NewFuncDeclaration("readFile", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=$(< \"${path}\") || nativeError \"readFile() - Cannot read file '${path}'\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: readLines
+func (self *Transpiler) nativeReadLines(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: readLines(str path)")
+	}
+	if err := self.validateArgType("readLines", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for readLines to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "readLines") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "readLines")
+		funcDecl := bashAst.NewFuncDeclaration("readLines", bashAst.StrArrayNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("mapfile -t tmpStrs < \"${path}\" || nativeError \"readLines() - Cannot read file '${path}'\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewArrayVal(scrilaAst.StrArrayValueType), nil
+}
+
+// MARK: remove
+func (self *Transpiler) nativeRemove(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: remove(str path)")
+	}
+	if err := self.validateArgType("remove", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for remove to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "remove") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "remove")
+		funcDecl := bashAst.NewFuncDeclaration(nativeBashFuncName("remove"), bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("rm -R -- \"${path}\" || nativeError \"remove() - Cannot remove '${path}'\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
+
+// MARK: writeFile
+func (self *Transpiler) nativeWriteFile(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: writeFile(str path, str content)")
+	}
+	if err := self.validateArgType("writeFile", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("writeFile", "content", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for writeFile to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "writeFile") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "writeFile")
+		funcDecl := bashAst.NewFuncDeclaration("writeFile", bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("content", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("printf '%s' \"${content}\" > \"${path}\" || nativeError \"writeFile() - Cannot write file '${path}'\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
@@ -0,0 +1,452 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+var flagNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*$`)
+var flagShortRegex = regexp.MustCompile(`^[a-zA-Z]?$`)
+
+// Placeholders of the flag values in the generated help
+var flagTypeHelpMapping = map[scrilaAst.NodeType]string{
+	scrilaAst.BoolLiteralNode: "",
+	scrilaAst.IntLiteralNode:  "int",
+	scrilaAst.StrLiteralNode:  "string",
+}
+
+var flagTypeBashMapping = map[scrilaAst.NodeType]string{
+	scrilaAst.BoolLiteralNode: "bool",
+	scrilaAst.IntLiteralNode:  "int",
+	scrilaAst.StrLiteralNode:  "str",
+}
+
+type flagDeclaration struct {
+	name         string
+	short        string
+	dataType     scrilaAst.NodeType
+	defaultValue string
+	description  string
+	required     bool
+	// Allowed values of an enum flag
+	choices []string
+}
+
+// Validates the args of a flag function and stores the flag for the generated option parser.
+// All args must be literals as the option parser and the help are generated at compile time.
+func (self *Transpiler) declareFlag(funcName string, args []scrilaAst.IExpr, dataType scrilaAst.NodeType, required bool, env *Environment) error {
+	paramNames := []string{"name", "short", "default", "description"}
+	paramTypes := []scrilaAst.NodeType{scrilaAst.StrLiteralNode, scrilaAst.StrLiteralNode, dataType, scrilaAst.StrLiteralNode}
+	if required || dataType == scrilaAst.BoolLiteralNode {
+		paramNames = slices.Delete(paramNames, 2, 3)
+		paramTypes = slices.Delete(paramTypes, 2, 3)
+	}
+
+	if len(args) != len(paramNames) {
+		params := []string{}
+		for i, paramName := range paramNames {
+			params = append(params, flagTypeBashMapping[paramTypes[i]]+" "+paramName)
+		}
+		return fmt.Errorf("Expected syntax: %s(%s)", funcName, strings.Join(params, ", "))
+	}
+	for i, arg := range args {
+		if err := self.validateArgType(funcName, paramNames[i], arg, paramTypes[i], env); err != nil {
+			return err
+		}
+		if arg.GetKind() != paramTypes[i] {
+			return fmt.Errorf("%s() - Parameter %s must be a literal", funcName, paramNames[i])
+		}
+	}
+
+	flag := &flagDeclaration{
+		name:        scrilaAst.ExprToStrLit(args[0]).GetValue(),
+		short:       scrilaAst.ExprToStrLit(args[1]).GetValue(),
+		dataType:    dataType,
+		description: scrilaAst.ExprToStrLit(args[len(args)-1]).GetValue(),
+		required:    required,
+	}
+	switch {
+	case required:
+	case dataType == scrilaAst.BoolLiteralNode:
+		flag.defaultValue = "false"
+	case dataType == scrilaAst.IntLiteralNode:
+		flag.defaultValue = fmt.Sprintf("%d", scrilaAst.ExprToIntLit(args[2]).GetValue())
+	default:
+		flag.defaultValue = scrilaAst.ExprToStrLit(args[2]).GetValue()
+	}
+
+	return self.addFlag(funcName, flag)
+}
+
+// Validates the name of the given flag and stores it for the generated option parser
+func (self *Transpiler) addFlag(funcName string, flag *flagDeclaration) error {
+	if !flagNameRegex.MatchString(flag.name) {
+		return fmt.Errorf("%s() - Invalid flag name '%s'", funcName, flag.name)
+	}
+	if !flagShortRegex.MatchString(flag.short) {
+		return fmt.Errorf("%s() - Invalid short flag '%s'. Expected a single letter or an empty string", funcName, flag.short)
+	}
+	if flag.name == "help" || flag.short == "h" {
+		return fmt.Errorf("%s() - The flags '--help' and '-h' are reserved for the generated help", funcName)
+	}
+	for _, declaredFlag := range self.flags {
+		if declaredFlag.name == flag.name {
+			return fmt.Errorf("%s() - The flag '--%s' is already declared", funcName, flag.name)
+		}
+		if flag.short != "" && declaredFlag.short == flag.short {
+			return fmt.Errorf("%s() - The flag '-%s' is already declared", funcName, flag.short)
+		}
+	}
+	self.flags = append(self.flags, flag)
+	return nil
+}
+
+// Adds the bash function of a flag native to the native body. It returns the parsed value of the flag.
+func (self *Transpiler) appendFlagFunc(funcName string, dataType scrilaAst.NodeType, params ...string) error {
+	if slices.Contains(self.usedNativeFunctions, funcName) {
+		return nil
+	}
+	self.usedNativeFunctions = append(self.usedNativeFunctions, funcName)
+
+	bashDataType, err := scrilaNodeTypeToBashNodeType(dataType)
+	if err != nil {
+		return err
+	}
+	tmpArrayName, err := scrilaNodeTypeToTmpArrayName(dataType)
+	if err != nil {
+		return err
+	}
+	funcDecl := bashAst.NewFuncDeclaration(funcName, bashDataType)
+	for _, param := range params {
+		paramType := bashAst.StrLiteralNode
+		switch param {
+		case "default":
+			paramType = bashDataType
+		case "choices":
+			paramType = bashAst.StrArrayNode
+		}
+		funcDecl.AppendParams(bashAst.NewFuncParameter(param, paramType))
+	}
+	funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("%s[${tmpIndex}]=\"${flagValues[${name}]}\"", tmpArrayName)))
+	self.bashProgram.AppendNativeBody(funcDecl)
+	return nil
+}
+
+// Generates the option parser and the help for the declared flags.
+// The parser is called at the start of the script. The remaining arguments replace the arguments of the script.
+func (self *Transpiler) appendFlagParser() {
+	if len(self.flags) == 0 {
+		return
+	}
+
+	// Help
+	helpDecl := bashAst.NewFuncDeclaration("flagHelp", bashAst.VoidNode)
+	helpDecl.AppendBody(bashAst.NewBashStmt("echo \"Usage: ${0##*/} [options] [arguments]\""))
+	helpDecl.AppendBody(bashAst.NewBashStmt("echo \"\""))
+	helpDecl.AppendBody(bashAst.NewBashStmt("echo \"Options:\""))
+	options := []string{}
+	descriptions := []string{}
+	for _, flag := range self.flags {
+		option := "    "
+		if flag.short != "" {
+			option = fmt.Sprintf("-%s, ", flag.short)
+		}
+		option += "--" + flag.name
+		if flagTypeHelpMapping[flag.dataType] != "" {
+			option += " " + flagTypeHelpMapping[flag.dataType]
+		}
+		options = append(options, option)
+
+		description := flag.description
+		switch {
+		case len(flag.choices) > 0:
+			description += fmt.Sprintf(" (one of: %s; default: %s)", strings.Join(flag.choices, ", "), flag.defaultValue)
+		case flag.required:
+			description += " (required)"
+		case flag.dataType != scrilaAst.BoolLiteralNode:
+			description += fmt.Sprintf(" (default: %s)", flag.defaultValue)
+		}
+		descriptions = append(descriptions, description)
+	}
+	options = append(options, "-h, --help")
+	descriptions = append(descriptions, "Show this help")
+	width := 0
+	for _, option := range options {
+		width = max(width, len(option))
+	}
+	for i, option := range options {
+		// Single quotes print the description as written instead of expanding variables and commands
+		helpDecl.AppendBody(bashAst.NewBashStmt("echo " + bashSingleQuote(fmt.Sprintf("  %-*s  %s", width, option, descriptions[i]))))
+	}
+	self.bashProgram.AppendNativeBody(helpDecl)
+
+	// Usage error
+	errorDecl := bashAst.NewFuncDeclaration("flagUsageError", bashAst.VoidNode)
+	errorDecl.AppendParams(bashAst.NewFuncParameter("message", bashAst.StrLiteralNode))
+	errorDecl.AppendBody(bashAst.NewBashStmt("echo \"${0##*/}: ${message}\" >&2"))
+	errorDecl.AppendBody(bashAst.NewBashStmt("echo \"Try '${0##*/} --help' for more information.\" >&2"))
+	errorDecl.AppendBody(bashAst.NewBashStmt("exit 2"))
+	self.bashProgram.AppendNativeBody(errorDecl)
+
+	// Parser
+	values := []string{}
+	types := []string{}
+	shorts := []string{}
+	choices := []string{}
+	for _, flag := range self.flags {
+		values = append(values, fmt.Sprintf("[%s]=\"%s\"", flag.name, flag.defaultValue))
+		if len(flag.choices) > 0 {
+			choices = append(choices, fmt.Sprintf("[%s]=\"%s\"", flag.name, strings.Join(flag.choices, " ")))
+		}
+		types = append(types, fmt.Sprintf("[%s]=\"%s\"", flag.name, flagTypeBashMapping[flag.dataType]))
+		if flag.short != "" {
+			shorts = append(shorts, fmt.Sprintf("[%s]=\"%s\"", flag.short, flag.name))
+		}
+	}
+	sort.Strings(shorts)
+	parserDecl := bashAst.NewFuncDeclaration("parseFlags", bashAst.VoidNode)
+	parserDecl.AppendParams(bashAst.NewFuncParameter("args", bashAst.StrArrayNode))
+	for _, line := range []string{
+		fmt.Sprintf("declare -g -A flagValues=(%s)", strings.Join(values, " ")),
+		fmt.Sprintf("local -A flagTypes=(%s)", strings.Join(types, " ")),
+		fmt.Sprintf("local -A flagShorts=(%s)", strings.Join(shorts, " ")),
+	} {
+		parserDecl.AppendBody(bashAst.NewBashStmt(line))
+	}
+	if len(choices) > 0 {
+		parserDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("local -A flagChoices=(%s)", strings.Join(choices, " "))))
+	}
+	for _, line := range []string{
+		"local -A flagsGiven=()",
+		"local i=0",
+		"local arg",
+		"local name",
+		"local value",
+		"local hasValue",
+		"flagArgs=()",
+		"while (( i < ${#args[@]} ))",
+		"do",
+		"\targ=\"${args[i]}\"",
+		"\ti=$((i + 1))",
+		"\tname=\"\"",
+		"\thasValue=\"false\"",
+		"\tcase \"${arg}\" in",
+		"\t\t--)",
+		"\t\t\tflagArgs+=(\"${args[@]:i}\")",
+		"\t\t\tbreak",
+		"\t\t\t;;",
+		"\t\t-h|--help)",
+		"\t\t\tflagHelp",
+		"\t\t\texit 0",
+		"\t\t\t;;",
+		"\t\t--*=*)",
+		"\t\t\tname=\"${arg%%=*}\"",
+		"\t\t\tname=\"${name#--}\"",
+		"\t\t\tvalue=\"${arg#*=}\"",
+		"\t\t\thasValue=\"true\"",
+		"\t\t\t;;",
+		"\t\t--?*)",
+		"\t\t\tname=\"${arg#--}\"",
+		"\t\t\t;;",
+		"\t\t-?)",
+		"\t\t\tname=\"${flagShorts[${arg#-}]}\"",
+		"\t\t\t;;",
+		"\t\t*)",
+		"\t\t\tflagArgs+=(\"${arg}\")",
+		"\t\t\tcontinue",
+		"\t\t\t;;",
+		"\tesac",
+		"\tif [[ -z ${name} || -z ${flagTypes[${name}]} ]]",
+		"\tthen",
+		"\t\tflagUsageError \"Unknown flag '${arg}'\"",
+		"\tfi",
+		"\tif [[ ${flagTypes[${name}]} == \"bool\" ]]",
+		"\tthen",
+		"\t\tif [[ ${hasValue} == \"false\" ]]",
+		"\t\tthen",
+		"\t\t\tvalue=\"true\"",
+		"\t\telif [[ ${value} != \"true\" && ${value} != \"false\" ]]",
+		"\t\tthen",
+		"\t\t\tflagUsageError \"Invalid value '${value}' for flag '--${name}'. Expected true or false\"",
+		"\t\tfi",
+		"\telif [[ ${hasValue} == \"false\" ]]",
+		"\tthen",
+		"\t\tif (( i >= ${#args[@]} ))",
+		"\t\tthen",
+		"\t\t\tflagUsageError \"Flag '${arg}' requires a value\"",
+		"\t\tfi",
+		"\t\tvalue=\"${args[i]}\"",
+		"\t\ti=$((i + 1))",
+		"\tfi",
+		"\tif [[ ${flagTypes[${name}]} == \"int\" && ! ${value} =~ ^-?[0-9]+$ ]]",
+		"\tthen",
+		"\t\tflagUsageError \"Invalid value '${value}' for flag '--${name}'. Expected an int\"",
+		"\tfi",
+	} {
+		parserDecl.AppendBody(bashAst.NewBashStmt(line))
+	}
+	if len(choices) > 0 {
+		// The allowed values of enum flags are surrounded by spaces to match them as whole words
+		parserDecl.AppendBody(bashAst.NewBashStmt("\tif [[ -n ${flagChoices[${name}]} && \" ${flagChoices[${name}]} \" != *\" ${value} \"* ]]"))
+		parserDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		parserDecl.AppendBody(bashAst.NewBashStmt("\t\tflagUsageError \"Invalid value '${value}' for flag '--${name}'. Expected one of: ${flagChoices[${name}]}\""))
+		parserDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+	}
+	for _, line := range []string{
+		"\tflagValues[${name}]=\"${value}\"",
+		"\tflagsGiven[${name}]=\"true\"",
+		"done",
+	} {
+		parserDecl.AppendBody(bashAst.NewBashStmt(line))
+	}
+	for _, flag := range self.flags {
+		if !flag.required {
+			continue
+		}
+		parserDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("if [[ -z ${flagsGiven[%s]} ]]", flag.name)))
+		parserDecl.AppendBody(bashAst.NewBashStmt("then"))
+		parserDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("\tflagUsageError \"Missing required flag '--%s'\"", flag.name)))
+		parserDecl.AppendBody(bashAst.NewBashStmt("fi"))
+	}
+	self.bashProgram.AppendNativeBody(parserDecl)
+
+	self.bashProgram.PrependUserBody(
+		bashAst.NewComment("Parse the command-line flags"),
+		bashAst.NewBashStmt("parseFlags \"$@\""),
+		bashAst.NewBashStmt("set -- \"${flagArgs[@]}\""),
+	)
+}
+
+// MARK: flagBool
+func (self *Transpiler) nativeFlagBool(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if err := self.declareFlag("flagBool", args, scrilaAst.BoolLiteralNode, false, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for flagBool to "usedNativeFunctions"
+	if err := self.appendFlagFunc("flagBool", scrilaAst.BoolLiteralNode, "name", "short", "description"); err != nil {
+		return NewNullVal(), err
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: flagEnum
+func (self *Transpiler) nativeFlagEnum(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 5 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: flagEnum(str name, str short, str default, str[] choices, str description)")
+	}
+	paramNames := []string{"name", "short", "default", "choices", "description"}
+	for i, arg := range args {
+		paramType := scrilaAst.StrLiteralNode
+		if i == 3 {
+			paramType = scrilaAst.ArrayLiteralNode
+		}
+		if arg.GetKind() != paramType {
+			return NewNullVal(), fmt.Errorf("flagEnum() - Parameter %s must be a literal", paramNames[i])
+		}
+	}
+	if err := self.validateArgType("flagEnum", "choices", args[3], scrilaAst.StrArrayNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	flag := &flagDeclaration{
+		name:         scrilaAst.ExprToStrLit(args[0]).GetValue(),
+		short:        scrilaAst.ExprToStrLit(args[1]).GetValue(),
+		dataType:     scrilaAst.StrLiteralNode,
+		defaultValue: scrilaAst.ExprToStrLit(args[2]).GetValue(),
+		description:  scrilaAst.ExprToStrLit(args[4]).GetValue(),
+	}
+	for _, choice := range scrilaAst.ExprToArray(args[3]).GetValues() {
+		if choice.GetKind() != scrilaAst.StrLiteralNode || !flagNameRegex.MatchString(scrilaAst.ExprToStrLit(choice).GetValue()) {
+			return NewNullVal(), fmt.Errorf("flagEnum() - The choices must be string literals starting with a letter and containing only letters, digits and dashes")
+		}
+		flag.choices = append(flag.choices, scrilaAst.ExprToStrLit(choice).GetValue())
+	}
+	if !slices.Contains(flag.choices, flag.defaultValue) {
+		return NewNullVal(), fmt.Errorf("flagEnum() - The default '%s' is not one of the choices", flag.defaultValue)
+	}
+	if err := self.addFlag("flagEnum", flag); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for flagEnum to "usedNativeFunctions"
+	if err := self.appendFlagFunc("flagEnum", scrilaAst.StrLiteralNode, "name", "short", "default", "choices", "description"); err != nil {
+		return NewNullVal(), err
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: flagInt
+func (self *Transpiler) nativeFlagInt(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if err := self.declareFlag("flagInt", args, scrilaAst.IntLiteralNode, false, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for flagInt to "usedNativeFunctions"
+	if err := self.appendFlagFunc("flagInt", scrilaAst.IntLiteralNode, "name", "short", "default", "description"); err != nil {
+		return NewNullVal(), err
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: flagIntRequired
+func (self *Transpiler) nativeFlagIntRequired(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if err := self.declareFlag("flagIntRequired", args, scrilaAst.IntLiteralNode, true, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for flagIntRequired to "usedNativeFunctions"
+	if err := self.appendFlagFunc("flagIntRequired", scrilaAst.IntLiteralNode, "name", "short", "description"); err != nil {
+		return NewNullVal(), err
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: flagStr
+func (self *Transpiler) nativeFlagStr(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if err := self.declareFlag("flagStr", args, scrilaAst.StrLiteralNode, false, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for flagStr to "usedNativeFunctions"
+	if err := self.appendFlagFunc("flagStr", scrilaAst.StrLiteralNode, "name", "short", "default", "description"); err != nil {
+		return NewNullVal(), err
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: flagStrRequired
+func (self *Transpiler) nativeFlagStrRequired(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if err := self.declareFlag("flagStrRequired", args, scrilaAst.StrLiteralNode, true, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for flagStrRequired to "usedNativeFunctions"
+	if err := self.appendFlagFunc("flagStrRequired", scrilaAst.StrLiteralNode, "name", "short", "description"); err != nil {
+		return NewNullVal(), err
+	}
+	return NewStrVal("str"), nil
+}
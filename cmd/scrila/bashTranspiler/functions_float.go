@@ -0,0 +1,156 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// Adds the bash function "floatCalc" to the native body.
+// Bash has no support for floating point numbers, so all float operations are delegated to awk.
+// Arithmetic operators print the result, comparison operators return the result as exit code.
+func (self *Transpiler) appendFloatCalcFunc() {
+	if slices.Contains(self.usedNativeFunctions, "floatCalc") {
+		return
+	}
+	self.usedNativeFunctions = append(self.usedNativeFunctions, "floatCalc")
+	funcDecl := bashAst.NewFuncDeclaration("floatCalc", bashAst.StrLiteralNode)
+	funcDecl.AppendParams(bashAst.NewFuncParameter("lhs", bashAst.StrLiteralNode))
+	funcDecl.AppendParams(bashAst.NewFuncParameter("operator", bashAst.StrLiteralNode))
+	funcDecl.AppendParams(bashAst.NewFuncParameter("rhs", bashAst.StrLiteralNode))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`LC_ALL=C awk -v lhs="${lhs}" -v operator="${operator}" -v rhs="${rhs}" 'BEGIN {`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	lhs += 0; rhs += 0`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == "<") exit !(lhs < rhs)`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == ">") exit !(lhs > rhs)`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == "<=") exit !(lhs <= rhs)`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == ">=") exit !(lhs >= rhs)`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == "==") exit !(lhs == rhs)`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == "!=") exit !(lhs != rhs)`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == "floor") { result = int(lhs); if (result > lhs) result--; printf "%d\n", result; exit }`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == "ceil") { result = int(lhs); if (result < lhs) result++; printf "%d\n", result; exit }`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == "round") { result = lhs < 0 ? -int(0.5 - lhs) : int(lhs + 0.5); printf "%d\n", result; exit }`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == "+") result = lhs + rhs`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == "-") result = lhs - rhs`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == "*") result = lhs * rhs`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (operator == "/") result = lhs / rhs`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	result = sprintf("%.15g", result)`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	if (result !~ /[.eEnN]/) result = result ".0"`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`	print result`))
+	funcDecl.AppendBody(bashAst.NewBashStmt(`}'`))
+	self.bashProgram.AppendNativeBody(funcDecl)
+}
+
+// Adds a native function with a single float parameter that rounds the value with the given operator of "floatCalc"
+func (self *Transpiler) appendFloatRoundingFunc(funcName string) {
+	self.appendFloatCalcFunc()
+	if slices.Contains(self.usedNativeFunctions, funcName) {
+		return
+	}
+	self.usedNativeFunctions = append(self.usedNativeFunctions, funcName)
+	funcDecl := bashAst.NewFuncDeclaration(funcName, bashAst.IntLiteralNode)
+	funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.FloatLiteralNode))
+	funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf(`tmpInts[${tmpIndex}]=$(floatCalc "${value}" "%s" 0)`, funcName)))
+	self.bashProgram.AppendNativeBody(funcDecl)
+}
+
+// MARK: ceil
+func (self *Transpiler) nativeCeil(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: ceil(float value)")
+	}
+	if err := self.validateArgType("ceil", "value", args[0], scrilaAst.FloatLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for ceil to "usedNativeFunctions"
+	self.appendFloatRoundingFunc("ceil")
+	return NewIntVal(1), nil
+}
+
+// MARK: floatToStr
+func (self *Transpiler) nativeFloatToStr(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: floatToStr(float value)")
+	}
+	if err := self.validateArgType("floatToStr", "value", args[0], scrilaAst.FloatLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for floatToStr to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "floatToStr") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "floatToStr")
+		funcDecl := bashAst.NewFuncDeclaration("floatToStr", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.FloatLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=${value}"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: floor
+func (self *Transpiler) nativeFloor(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: floor(float value)")
+	}
+	if err := self.validateArgType("floor", "value", args[0], scrilaAst.FloatLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for floor to "usedNativeFunctions"
+	self.appendFloatRoundingFunc("floor")
+	return NewIntVal(1), nil
+}
+
+// MARK: round
+func (self *Transpiler) nativeRound(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: round(float value)")
+	}
+	if err := self.validateArgType("round", "value", args[0], scrilaAst.FloatLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for round to "usedNativeFunctions"
+	self.appendFloatRoundingFunc("round")
+	return NewIntVal(1), nil
+}
+
+// MARK: strToFloat
+func (self *Transpiler) nativeStrToFloat(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strToFloat(str value)")
+	}
+	if err := self.validateArgType("strToFloat", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// TODO After error handling in ScriLa is thought-out: Add error handling for the case that the value is not a float.
+
+	// Add bash code for strToFloat to "usedNativeFunctions"
+	self.appendFloatCalcFunc()
+	if !slices.Contains(self.usedNativeFunctions, "strToFloat") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strToFloat")
+		funcDecl := bashAst.NewFuncDeclaration("strToFloat", bashAst.FloatLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt(`tmpFloats[${tmpIndex}]=$(floatCalc "${value}" "+" 0)`))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewFloatVal(1), nil
+}
@@ -0,0 +1,152 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Matches a format verb with optional flags, width and precision e.g. "%-10s" or "%5.2f"
+var formatVerbRegex = regexp.MustCompile(`%[-+ 0#]*[0-9]*(\.[0-9]+)?([a-zA-Z%])?`)
+
+// Value types accepted by the format verbs
+var formatVerbTypes = map[string][]scrilaAst.NodeType{
+	"b": {scrilaAst.BoolLiteralNode},
+	"d": {scrilaAst.IntLiteralNode},
+	"e": {scrilaAst.FloatLiteralNode, scrilaAst.IntLiteralNode},
+	"f": {scrilaAst.FloatLiteralNode, scrilaAst.IntLiteralNode},
+	"g": {scrilaAst.FloatLiteralNode, scrilaAst.IntLiteralNode},
+	"i": {scrilaAst.IntLiteralNode},
+	"o": {scrilaAst.IntLiteralNode},
+	"s": {scrilaAst.StrLiteralNode},
+	"x": {scrilaAst.IntLiteralNode},
+	"X": {scrilaAst.IntLiteralNode},
+}
+
+var formatTypeDescriptionMapping = map[scrilaAst.NodeType]string{
+	scrilaAst.BoolLiteralNode:  "bool",
+	scrilaAst.FloatLiteralNode: "float",
+	scrilaAst.IntLiteralNode:   "int",
+	scrilaAst.StrLiteralNode:   "str",
+}
+
+// Validates the values of a format function.
+// If the format is a string literal, each verb is checked against the type of its value.
+func (self *Transpiler) validateFormatArgs(funcName string, args []scrilaAst.IExpr, env *Environment) error {
+	if err := self.validateArgType(funcName, "fmt", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return err
+	}
+	values := args[1:]
+
+	if args[0].GetKind() != scrilaAst.StrLiteralNode {
+		// The format is only known at runtime. Only check that all values are printable.
+		for _, value := range values {
+			if _, err := self.getFormatValueType(funcName, value, env); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	verbs := []string{}
+	for _, verb := range formatVerbRegex.FindAllStringSubmatch(scrilaAst.ExprToStrLit(args[0]).GetValue(), -1) {
+		if verb[0] == "%%" {
+			continue
+		}
+		if _, ok := formatVerbTypes[verb[2]]; !ok {
+			return fmt.Errorf("%s() - Unsupported format verb '%s'", funcName, verb[0])
+		}
+		verbs = append(verbs, verb[0])
+	}
+	if len(verbs) != len(values) {
+		return fmt.Errorf("%s() - The format contains %d verbs but %d values are given", funcName, len(verbs), len(values))
+	}
+
+	for i, verb := range verbs {
+		valueType, err := self.getFormatValueType(funcName, values[i], env)
+		if err != nil {
+			return err
+		}
+		wantedTypes := formatVerbTypes[verb[len(verb)-1:]]
+		if !slices.Contains(wantedTypes, valueType) {
+			typeNames := []string{}
+			for _, wantedType := range wantedTypes {
+				typeNames = append(typeNames, formatTypeDescriptionMapping[wantedType])
+			}
+			return fmt.Errorf("%s() - Verb '%s' expects a value of type %s. Got '%s'", funcName, verb, strings.Join(typeNames, " or "), valueType)
+		}
+	}
+	return nil
+}
+
+// Returns the type of the given format value. Only str, int, bool and float values are allowed.
+func (self *Transpiler) getFormatValueType(funcName string, value scrilaAst.IExpr, env *Environment) (scrilaAst.NodeType, error) {
+	givenType := value.GetKind()
+	if givenType != scrilaAst.ArrayLiteralNode {
+		for _, wantedType := range []scrilaAst.NodeType{scrilaAst.StrLiteralNode, scrilaAst.IntLiteralNode, scrilaAst.BoolLiteralNode, scrilaAst.FloatLiteralNode} {
+			doMatch, matchedType, err := self.exprIsType(value, wantedType, env)
+			if err != nil {
+				return "", err
+			}
+			if doMatch {
+				return wantedType, nil
+			}
+			givenType = matchedType
+		}
+	}
+	return "", fmt.Errorf("%s() - Parameter values must be of type str, int, bool or float. Got '%s'", funcName, givenType)
+}
+
+// MARK: format
+func (self *Transpiler) nativeFormat(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) == 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: format(str fmt, values...)")
+	}
+	if err := self.validateFormatArgs("format", args, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for format to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "format") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "format")
+		// The values are passed as one array. LC_NUMERIC=C makes "%f" independent of the locale.
+		funcDecl := bashAst.NewFuncDeclaration("format", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("fmt", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("values", bashAst.StrArrayNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("LC_NUMERIC=C printf -v \"tmpStrs[${tmpIndex}]\" \"${fmt}\" \"${values[@]}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: printF
+func (self *Transpiler) nativePrintF(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) == 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: printF(str fmt, values...)")
+	}
+	if err := self.validateFormatArgs("printF", args, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for printF to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "printF") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "printF")
+		// The values are passed as one array. LC_NUMERIC=C makes "%f" independent of the locale.
+		funcDecl := bashAst.NewFuncDeclaration("printF", bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("fmt", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("values", bashAst.StrArrayNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("LC_NUMERIC=C printf \"${fmt}\" \"${values[@]}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
@@ -0,0 +1,134 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// MARK: inputSecret
+func (self *Transpiler) nativeInputSecret(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: inputSecret(str prompt)")
+	}
+	if err := self.validateArgType("inputSecret", "prompt", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for inputSecret to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "inputSecret") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "inputSecret")
+		// The typed characters are not echoed. Print the missing newline after the input
+		funcDecl := bashAst.NewFuncDeclaration("inputSecret", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("prompt", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("read -r -s -p \"${prompt} \" \"tmpStrs[${tmpIndex}]\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -t 0 ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\techo >&2"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: inputTimeout
+func (self *Transpiler) nativeInputTimeout(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 3 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: inputTimeout(str prompt, int seconds, str default)")
+	}
+	if err := self.validateArgType("inputTimeout", "prompt", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("inputTimeout", "seconds", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("inputTimeout", "default", args[2], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for inputTimeout to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "inputTimeout") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "inputTimeout")
+		// Fall back to the default on timeout or end of input
+		funcDecl := bashAst.NewFuncDeclaration("inputTimeout", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("prompt", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("seconds", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("default", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if ! read -r -t \"${seconds}\" -p \"${prompt} \" \"tmpStrs[${tmpIndex}]\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpStrs[${tmpIndex}]=\"${default}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: readAll
+func (self *Transpiler) nativeReadAll(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: readAll()")
+	}
+
+	// Add bash code for readAll to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "readAll") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "readAll")
+		funcDecl := bashAst.NewFuncDeclaration("readAll", bashAst.StrLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"$(cat)\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: readLine
+func (self *Transpiler) nativeReadLine(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: readLine()")
+	}
+
+	// Add bash code for readLine to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "readLine") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "readLine")
+		funcDecl := bashAst.NewFuncDeclaration("readLine", bashAst.StrLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("IFS= read -r \"tmpStrs[${tmpIndex}]\" || true"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: stdinIsTerminal
+func (self *Transpiler) nativeStdinIsTerminal(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: stdinIsTerminal()")
+	}
+
+	// Add bash code for stdinIsTerminal to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "stdinIsTerminal") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "stdinIsTerminal")
+		funcDecl := bashAst.NewFuncDeclaration("stdinIsTerminal", bashAst.BoolLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -t 0 ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"true\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"false\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
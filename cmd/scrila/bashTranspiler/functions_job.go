@@ -0,0 +1,286 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// Validates that the given arg is a job
+func (self *Transpiler) validateJobArg(funcName string, arg scrilaAst.IExpr, env *Environment) error {
+	return self.validateArgType(funcName, "job", arg, scrilaAst.JobNode, env)
+}
+
+// Adds the bash code of "wait" which collects the exit code and the result of a job
+func (self *Transpiler) appendJobWaitFunc() {
+	if !slices.Contains(self.usedNativeFunctions, "wait") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "wait")
+		// A job is only waited for once. Later calls return the stored exit code.
+		funcDecl := bashAst.NewFuncDeclaration(nativeBashFuncName("wait"), bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("job", bashAst.JobNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -n \"${jobFiles[${job}]}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\twait \"${job}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tjobExitCodes[${job}]=$?"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tjobResults[${job}]=\"$(< \"${jobFiles[${job}]}\")\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\trm -f \"${jobFiles[${job}]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tunset \"jobFiles[${job}]\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=${jobExitCodes[${job}]}"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+}
+
+// Adds the bash code of "parallelFor" which runs the body of a parallel for loop for each value as background job
+func (self *Transpiler) appendParallelForFunc() {
+	if !slices.Contains(self.usedNativeFunctions, "parallelFor") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "parallelFor")
+		// "wait -n" returns as soon as one of the running iterations is finished so that the next one can be started.
+		// The exit codes of all iterations are collected afterwards in "parallelCodes". Not started iterations get -1.
+		// With fail-fast the exit code of the failed iteration is reported instead of the one of an iteration killed afterwards.
+		funcDecl := bashAst.NewFuncDeclaration("parallelFor", bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("limit", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("failFast", bashAst.BoolLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("fn", bashAst.FuncRefNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("values", bashAst.ArrayLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local index"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local pid"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local code"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local running=0"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local failed=false"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local failedCode=0"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local pids=()"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local active=()"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("for index in \"${!values[@]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ ${limit} -gt 0 && ${running} -ge ${limit} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\twait -n -p pid \"${active[@]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tcode=$?"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tunset \"active[${pid}]\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\trunning=$((running - 1))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tif [[ ${code} -ne 0 && ${failFast} == \"true\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\t\tfailed=true"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\t\tfailedCode=${code}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\t\tbreak"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t( \"${fn}\" \"${values[${index}]}\" ) &"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tpids[${index}]=$!"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tactive[$!]=$!"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\trunning=$((running + 1))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("while [[ ${failFast} == \"true\" && ${failed} == false && ${running} -gt 0 ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\twait -n -p pid \"${active[@]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tcode=$?"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tunset \"active[${pid}]\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\trunning=$((running - 1))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ ${code} -ne 0 ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tfailed=true"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tfailedCode=${code}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${failed} == true ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfor pid in \"${active[@]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tdo"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tkill -TERM \"${pid}\" $(pgrep -P \"${pid}\") 2> /dev/null"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tdone"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("parallelCodes=()"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("execExitCode=0"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("for index in \"${!values[@]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tcode=-1"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ -n \"${pids[${index}]}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\twait \"${pids[${index}]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tcode=$?"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tparallelCodes[${index}]=${code}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ ${execExitCode} -eq 0 ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\texecExitCode=${code}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${failed} == true ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\texecExitCode=${failedCode}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+}
+
+// MARK: jobResult
+func (self *Transpiler) nativeJobResult(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: jobResult(job job)")
+	}
+	if err := self.validateJobArg("jobResult", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+
+	self.appendJobWaitFunc()
+
+	// Add bash code for jobResult to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "jobResult") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "jobResult")
+		funcDecl := bashAst.NewFuncDeclaration("jobResult", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("job", bashAst.JobNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt(nativeBashFuncName("wait") + " \"${job}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${jobResults[${job}]}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: kill
+func (self *Transpiler) nativeKill(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: kill(job job)")
+	}
+	if err := self.validateJobArg("kill", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for kill to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "kill") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "kill")
+		// The commands started by the job are stopped too as they would keep running otherwise
+		funcDecl := bashAst.NewFuncDeclaration(nativeBashFuncName("kill"), bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("job", bashAst.JobNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local children"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("children=\"$(pgrep -P \"${job}\")\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("kill -TERM \"${job}\" ${children} 2> /dev/null"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
+
+// MARK: parallelExitCodes
+func (self *Transpiler) nativeParallelExitCodes(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: parallelExitCodes()")
+	}
+
+	// Add bash code for parallelExitCodes to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "parallelExitCodes") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "parallelExitCodes")
+		funcDecl := bashAst.NewFuncDeclaration("parallelExitCodes", bashAst.IntArrayNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts=(\"${parallelCodes[@]}\")"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewArrayVal(scrilaAst.IntArrayValueType), nil
+}
+
+// MARK: spawn
+func (self *Transpiler) nativeSpawn(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: spawn(func fn)")
+	}
+	fn, err := self.validateCallbackArg("spawn", "fn", args[0], []scrilaAst.NodeType{}, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	resultVar := ""
+	if fn.GetReturnType() != scrilaAst.VoidNode {
+		if resultVar, err = scrilaNodeTypeToTmpArrayName(fn.GetReturnType()); err != nil || scrilaNodeTypeIsArray(fn.GetReturnType()) {
+			return NewNullVal(), fmt.Errorf("spawn() - Function '%s' must return a bool, float, int, str or void. Got '%s'", fn.GetName(), fn.GetReturnType())
+		}
+	}
+	if varName := findChangedCapturedVar(fn.GetBody(), []string{}, fn.GetDeclarationEnv()); varName != "" {
+		return NewNullVal(), fmt.Errorf("spawn() - Function '%s' must not change the captured variable '%s' as it runs in a background job", fn.GetName(), varName)
+	}
+	self.spawnResultVars[fn.GetName()] = resultVar
+
+	// Add bash code for spawn to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "spawn") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "spawn")
+		// "tmpStrs" and the other temporary arrays do not survive the subshell.
+		// So the result of the function is written to a temporary file of the job.
+		funcDecl := bashAst.NewFuncDeclaration("spawn", bashAst.JobNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("fn", bashAst.FuncRefNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("resultVar", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local file"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("file=\"$(mktemp)\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("("))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\"${fn}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ -n \"${resultVar}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tlocal -n result=${resultVar}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tprintf '%s' \"${result[${tmpIndex}]}\" > \"${file}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt(") &"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("jobFiles[$!]=\"${file}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=$!"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return scrilaAst.NewRuntimeVal(scrilaAst.JobValueType), nil
+}
+
+func (self *Transpiler) inlineSpawn(bashArgs []bashAst.IStatement) []bashAst.IStatement {
+	resultVar := self.spawnResultVars[bashAst.StmtToStrLiteral(bashArgs[0]).GetValue()]
+	return []bashAst.IStatement{bashAst.NewCallExpr("spawn", append(bashArgs, bashAst.NewStrLiteral(resultVar)))}
+}
+
+// MARK: wait
+func (self *Transpiler) nativeWait(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: wait(job job)")
+	}
+	if err := self.validateJobArg("wait", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+
+	self.appendJobWaitFunc()
+	return NewIntVal(1), nil
+}
+
+// MARK: waitAll
+func (self *Transpiler) nativeWaitAll(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: waitAll(job[] jobs)")
+	}
+	if err := self.validateArgType("waitAll", "jobs", args[0], scrilaAst.JobArrayNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	self.appendJobWaitFunc()
+
+	// Add bash code for waitAll to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "waitAll") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "waitAll")
+		funcDecl := bashAst.NewFuncDeclaration("waitAll", bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("jobs", bashAst.JobArrayNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local job"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("for job in \"${jobs[@]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t" + nativeBashFuncName("wait") + " \"${job}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
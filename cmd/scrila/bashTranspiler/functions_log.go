@@ -0,0 +1,122 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Log levels in ascending order of severity
+var logLevels = []string{"DEBUG", "INFO", "WARN", "ERROR"}
+
+// Environment variable that holds the minimum log level of the generated script
+const logLevelEnvVar = "SCRILA_LOG_LEVEL"
+
+// Adds the bash function "nativeLog" to the native body.
+// It writes a timestamped line with the given level to stderr if the level is not below the minimum log level.
+func (self *Transpiler) appendNativeLogFunc() {
+	if slices.Contains(self.usedNativeFunctions, "nativeLog") {
+		return
+	}
+	self.usedNativeFunctions = append(self.usedNativeFunctions, "nativeLog")
+	funcDecl := bashAst.NewFuncDeclaration("nativeLog", bashAst.VoidNode)
+	funcDecl.AppendParams(bashAst.NewFuncParameter("level", bashAst.StrLiteralNode))
+	funcDecl.AppendParams(bashAst.NewFuncParameter("message", bashAst.StrLiteralNode))
+	funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("local levels=\"%s\"", strings.Join(logLevels, " "))))
+	funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("local minLevel=\"${%s:-INFO}\"", logLevelEnvVar)))
+	funcDecl.AppendBody(bashAst.NewBashStmt("minLevel=\"${minLevel^^}\""))
+	funcDecl.AppendBody(bashAst.NewBashStmt("if [[ \" ${levels} \" != *\" ${minLevel} \"* ]]"))
+	funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+	funcDecl.AppendBody(bashAst.NewBashStmt("\tminLevel=\"INFO\""))
+	funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+	funcDecl.AppendBody(bashAst.NewBashStmt("# Levels from the minimum level upwards e.g. \"WARN ERROR\""))
+	funcDecl.AppendBody(bashAst.NewBashStmt("local enabledLevels=\"${minLevel}${levels#*${minLevel}}\""))
+	funcDecl.AppendBody(bashAst.NewBashStmt("if [[ \" ${enabledLevels} \" == *\" ${level} \"* ]]"))
+	funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+	funcDecl.AppendBody(bashAst.NewBashStmt("\tprintf '%(%Y-%m-%d %H:%M:%S)T [%s] %s\\n' -1 \"${level}\" \"${message}\" >&2"))
+	funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+	self.bashProgram.AppendNativeBody(funcDecl)
+}
+
+// Validates the args of the log function with the given name and adds its bash code to the native body.
+func (self *Transpiler) nativeLogWithLevel(funcName string, level string, args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: %s(str message)", funcName)
+	}
+	if err := self.validateArgType(funcName, "message", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for the log function to "usedNativeFunctions"
+	self.appendNativeLogFunc()
+	if !slices.Contains(self.usedNativeFunctions, funcName) {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, funcName)
+		funcDecl := bashAst.NewFuncDeclaration(funcName, bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("message", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("nativeLog \"%s\" \"${message}\"", level)))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
+
+// MARK: logDebug
+func (self *Transpiler) nativeLogDebug(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+	return self.nativeLogWithLevel("logDebug", "DEBUG", args, env)
+}
+
+// MARK: logError
+func (self *Transpiler) nativeLogError(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+	return self.nativeLogWithLevel("logError", "ERROR", args, env)
+}
+
+// MARK: logInfo
+func (self *Transpiler) nativeLogInfo(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+	return self.nativeLogWithLevel("logInfo", "INFO", args, env)
+}
+
+// MARK: logWarn
+func (self *Transpiler) nativeLogWarn(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+	return self.nativeLogWithLevel("logWarn", "WARN", args, env)
+}
+
+// MARK: setLogLevel
+func (self *Transpiler) nativeSetLogLevel(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: setLogLevel(str level)")
+	}
+	if err := self.validateArgType("setLogLevel", "level", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if args[0].GetKind() == scrilaAst.StrLiteralNode {
+		level := scrilaAst.ExprToStrLit(args[0]).GetValue()
+		if !slices.Contains(logLevels, strings.ToUpper(level)) {
+			return NewNullVal(), fmt.Errorf("setLogLevel() - Invalid log level '%s'. Expected one of %s", level, strings.Join(logLevels, ", "))
+		}
+	}
+
+	// Add bash code for setLogLevel to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "setLogLevel") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "setLogLevel")
+		funcDecl := bashAst.NewFuncDeclaration("setLogLevel", bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("level", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("if [[ \" %s \" != *\" ${level^^} \"* ]]", strings.Join(logLevels, " "))))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("\tnativeError \"setLogLevel() - Invalid log level '${level}'. Expected one of %s\"", strings.Join(logLevels, ", "))))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("%s=\"${level^^}\"", logLevelEnvVar)))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
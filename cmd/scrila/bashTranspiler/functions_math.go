@@ -0,0 +1,326 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// Rejects a division by a literal zero when transpiling.
+// Other divisors are not checked as Bash already stops the script on an int division by zero.
+func validateDivisor(divisor scrilaAst.IExpr) error {
+	switch divisor.GetKind() {
+	case scrilaAst.IntLiteralNode:
+		if scrilaAst.ExprToIntLit(divisor).GetValue() == 0 {
+			return fmt.Errorf("Division by zero")
+		}
+	case scrilaAst.FloatLiteralNode:
+		if scrilaAst.ExprToFloatLit(divisor).GetValue() == 0 {
+			return fmt.Errorf("Division by zero")
+		}
+	}
+	return nil
+}
+
+// MARK: abs
+func (self *Transpiler) nativeAbs(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: abs(int value)")
+	}
+	if err := self.validateArgType("abs", "value", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for abs to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "abs") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "abs")
+		funcDecl := bashAst.NewFuncDeclaration("abs", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=$(( value < 0 ? -value : value ))"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: clamp
+func (self *Transpiler) nativeClamp(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 3 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: clamp(int value, int min, int max)")
+	}
+	if err := self.validateArgType("clamp", "value", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("clamp", "min", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("clamp", "max", args[2], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for clamp to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "clamp") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "clamp")
+		funcDecl := bashAst.NewFuncDeclaration("clamp", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("min", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("max", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=$(( value < min ? min : (value > max ? max : value) ))"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: gcd
+func (self *Transpiler) nativeGcd(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: gcd(int a, int b)")
+	}
+	if err := self.validateArgType("gcd", "a", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("gcd", "b", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for gcd to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "gcd") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "gcd")
+		funcDecl := bashAst.NewFuncDeclaration("gcd", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("a", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("b", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("a=$(( a < 0 ? -a : a ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("b=$(( b < 0 ? -b : b ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local rest"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("while (( b != 0 ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\trest=$(( a % b ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ta=${b}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tb=${rest}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=${a}"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: max
+func (self *Transpiler) nativeMax(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: max(int a, int b)")
+	}
+	if err := self.validateArgType("max", "a", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("max", "b", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for max to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "max") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "max")
+		funcDecl := bashAst.NewFuncDeclaration("max", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("a", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("b", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=$(( a > b ? a : b ))"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: min
+func (self *Transpiler) nativeMin(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: min(int a, int b)")
+	}
+	if err := self.validateArgType("min", "a", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("min", "b", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for min to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "min") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "min")
+		funcDecl := bashAst.NewFuncDeclaration("min", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("a", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("b", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=$(( a < b ? a : b ))"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: pow
+func (self *Transpiler) nativePow(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pow(int base, int exponent)")
+	}
+	if err := self.validateArgType("pow", "base", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("pow", "exponent", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for pow to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "pow") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "pow")
+		// Integer power. A negative exponent results in 0 as Bash arithmetic does not support it.
+		funcDecl := bashAst.NewFuncDeclaration("pow", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("base", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("exponent", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if (( exponent < 0 ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpInts[${tmpIndex}]=0"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\treturn"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=$(( base ** exponent ))"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: random
+func (self *Transpiler) nativeRandom(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: random(int lo, int hi)")
+	}
+	if err := self.validateArgType("random", "lo", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("random", "hi", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for random to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "random") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "random")
+		// Uses $SRANDOM (Bash 5.1+) unless the generator was seeded with randomSeed as only $RANDOM can be seeded
+		funcDecl := bashAst.NewFuncDeclaration("random", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("lo", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("hi", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if (( hi < lo ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tlocal swap=${lo}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tlo=${hi}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\thi=${swap}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local value"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -z \"${randomSeeded}\" ]] && [[ -n \"${SRANDOM}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tvalue=${SRANDOM}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tvalue=$(( (RANDOM << 15) | RANDOM ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=$(( lo + value % (hi - lo + 1) ))"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: randomSeed
+func (self *Transpiler) nativeRandomSeed(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: randomSeed(int seed)")
+	}
+	if err := self.validateArgType("randomSeed", "seed", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for randomSeed to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "randomSeed") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "randomSeed")
+		funcDecl := bashAst.NewFuncDeclaration("randomSeed", bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("seed", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("RANDOM=${seed}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("randomSeeded=\"true\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
+
+// MARK: sign
+func (self *Transpiler) nativeSign(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: sign(int value)")
+	}
+	if err := self.validateArgType("sign", "value", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for sign to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "sign") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "sign")
+		funcDecl := bashAst.NewFuncDeclaration("sign", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=$(( (value > 0) - (value < 0) ))"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: sqrt
+func (self *Transpiler) nativeSqrt(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: sqrt(int value)")
+	}
+	if err := self.validateArgType("sqrt", "value", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for sqrt to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "sqrt") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "sqrt")
+		// Integer square root using the Newton method. Negative values result in 0.
+		funcDecl := bashAst.NewFuncDeclaration("sqrt", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if (( value < 2 ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpInts[${tmpIndex}]=$(( value < 0 ? 0 : value ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\treturn"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local x=${value}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local y=$(( (x + 1) / 2 ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("while (( y < x ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tx=${y}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ty=$(( (x + value / x) / 2 ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=${x}"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
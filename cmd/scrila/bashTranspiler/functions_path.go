@@ -0,0 +1,244 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// MARK: pathAbs
+func (self *Transpiler) nativePathAbs(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pathAbs(str path)")
+	}
+	if err := self.validateArgType("pathAbs", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for pathAbs to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "pathAbs") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "pathAbs")
+		funcDecl := bashAst.NewFuncDeclaration("pathAbs", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=$(realpath -m -- \"${path}\") || nativeError \"pathAbs() - Cannot resolve path '${path}'\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: pathBase
+func (self *Transpiler) nativePathBase(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pathBase(str path)")
+	}
+	if err := self.validateArgType("pathBase", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for pathBase to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "pathBase") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "pathBase")
+		funcDecl := bashAst.NewFuncDeclaration("pathBase", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("# Remove trailing slashes except for the root directory"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("while [[ ${path} == */ && ${path} != / ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tpath=${path%/}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${path} == / ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpStrs[${tmpIndex}]=\"/\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpStrs[${tmpIndex}]=\"${path##*/}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: pathDir
+func (self *Transpiler) nativePathDir(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pathDir(str path)")
+	}
+	if err := self.validateArgType("pathDir", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for pathDir to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "pathDir") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "pathDir")
+		funcDecl := bashAst.NewFuncDeclaration("pathDir", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("# Remove trailing slashes except for the root directory"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("while [[ ${path} == */ && ${path} != / ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tpath=${path%/}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${path} != */* ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpStrs[${tmpIndex}]=\".\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\treturn"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("path=${path%/*}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("# Remove trailing slashes except for the root directory"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("while [[ ${path} == */ && ${path} != / ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tpath=${path%/}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${path:-/}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: pathExt
+func (self *Transpiler) nativePathExt(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pathExt(str path)")
+	}
+	if err := self.validateArgType("pathExt", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for pathExt to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "pathExt") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "pathExt")
+		funcDecl := bashAst.NewFuncDeclaration("pathExt", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("# Remove trailing slashes except for the root directory"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("while [[ ${path} == */ && ${path} != / ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tpath=${path%/}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local name=${path##*/}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local rest=${name#.}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local ext=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${rest} == *.* && -n ${rest##*.} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\text=\".${rest##*.}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${ext}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: pathJoin
+func (self *Transpiler) nativePathJoin(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) == 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pathJoin(str parts...)")
+	}
+	for _, arg := range args {
+		if err := self.validateArgType("pathJoin", "parts", arg, scrilaAst.StrLiteralNode, env); err != nil {
+			return NewNullVal(), err
+		}
+	}
+
+	// Add bash code for pathJoin to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "pathJoin") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "pathJoin")
+		// All arguments are passed as one array to join any number of parts
+		funcDecl := bashAst.NewFuncDeclaration("pathJoin", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("parts", bashAst.StrArrayNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local joined=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local part"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("for part in \"${parts[@]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ -z ${part} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tcontinue"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ -z ${joined} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tjoined=${part}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\telse"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tjoined=\"${joined%/}/${part#/}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=${joined}"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: pathRel
+func (self *Transpiler) nativePathRel(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pathRel(str path, str base)")
+	}
+	if err := self.validateArgType("pathRel", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("pathRel", "base", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for pathRel to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "pathRel") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "pathRel")
+		funcDecl := bashAst.NewFuncDeclaration("pathRel", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("base", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=$(realpath -m --relative-to=\"${base}\" -- \"${path}\") || nativeError \"pathRel() - Cannot resolve path '${path}' relative to '${base}'\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: pathStem
+func (self *Transpiler) nativePathStem(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pathStem(str path)")
+	}
+	if err := self.validateArgType("pathStem", "path", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for pathStem to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "pathStem") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "pathStem")
+		funcDecl := bashAst.NewFuncDeclaration("pathStem", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("path", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("# Remove trailing slashes except for the root directory"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("while [[ ${path} == */ && ${path} != / ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tpath=${path%/}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local name=${path##*/}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local rest=${name#.}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local ext=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${rest} == *.* && -n ${rest##*.} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\text=\".${rest##*.}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${name%\"${ext}\"}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
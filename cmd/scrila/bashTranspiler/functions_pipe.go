@@ -0,0 +1,196 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Redirection operators of "pipeRedirect". All except "2>&1" are followed by a file as target.
+var pipeRedirectOperators = []string{"<", ">", ">>", "2>&1"}
+
+// Validates the commands of a pipeline.
+// They must be given as array literal because the pipeline is built when transpiling.
+func (self *Transpiler) validatePipelineCommands(funcName string, arg scrilaAst.IExpr, env *Environment) error {
+	if err := self.validateArgType(funcName, "commands", arg, scrilaAst.StrArrayNode, env); err != nil {
+		return err
+	}
+	if arg.GetKind() != scrilaAst.ArrayLiteralNode {
+		return fmt.Errorf("%s() - Parameter commands must be an array literal", funcName)
+	}
+	if len(scrilaAst.ExprToArray(arg).GetValues()) == 0 {
+		return fmt.Errorf("%s() - Parameter commands must contain at least one command", funcName)
+	}
+	return nil
+}
+
+// Returns the Bash statements executing the given commands as pipeline.
+// If the output is captured, it is stored like the result of a native function.
+// The exit code is stored for "lastExitCode".
+func newPipelineStmts(commands bashAst.IStatement, captureOutput bool, redirections ...bashAst.IRedirection) []bashAst.IStatement {
+	pipeline := bashAst.NewPipeline(bashAst.StmtToArray(commands).GetValues())
+	for _, redirection := range redirections {
+		pipeline.AppendRedirection(redirection)
+	}
+
+	var stmt bashAst.IStatement = pipeline
+	if captureOutput {
+		stmt = bashAst.NewAssignmentExpr(bashAst.NewVarLiteral("tmpStrs[${tmpIndex}]", bashAst.StrLiteralNode), pipeline, false)
+	}
+	return []bashAst.IStatement{stmt, bashAst.NewBashStmt("execExitCode=$?")}
+}
+
+// MARK: pipe
+func (self *Transpiler) nativePipe(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pipe(str[] commands)")
+	}
+	if err := self.validatePipelineCommands("pipe", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+	return NewStrVal("str"), nil
+}
+
+func (self *Transpiler) inlinePipe(bashArgs []bashAst.IStatement) []bashAst.IStatement {
+	return newPipelineStmts(bashArgs[0], true)
+}
+
+// MARK: pipeAppendFile
+func (self *Transpiler) nativePipeAppendFile(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pipeAppendFile(str[] commands, str path)")
+	}
+	if err := self.validatePipelineCommands("pipeAppendFile", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("pipeAppendFile", "path", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	return NewNullVal(), nil
+}
+
+func (self *Transpiler) inlinePipeAppendFile(bashArgs []bashAst.IStatement) []bashAst.IStatement {
+	return newPipelineStmts(bashArgs[0], false, bashAst.NewRedirection(">>", bashArgs[1]))
+}
+
+// MARK: pipeFromFile
+func (self *Transpiler) nativePipeFromFile(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pipeFromFile(str[] commands, str path)")
+	}
+	if err := self.validatePipelineCommands("pipeFromFile", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("pipeFromFile", "path", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	return NewStrVal("str"), nil
+}
+
+func (self *Transpiler) inlinePipeFromFile(bashArgs []bashAst.IStatement) []bashAst.IStatement {
+	return newPipelineStmts(bashArgs[0], true, bashAst.NewRedirection("<", bashArgs[1]))
+}
+
+// MARK: pipeRedirect
+func (self *Transpiler) nativePipeRedirect(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pipeRedirect(str[] commands, str[] redirections)")
+	}
+	if err := self.validatePipelineCommands("pipeRedirect", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("pipeRedirect", "redirections", args[1], scrilaAst.StrArrayNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if args[1].GetKind() != scrilaAst.ArrayLiteralNode {
+		return NewNullVal(), fmt.Errorf("pipeRedirect() - Parameter redirections must be an array literal")
+	}
+	// The operators must be literals as they are written to the script. The targets can be any string.
+	redirections := scrilaAst.ExprToArray(args[1]).GetValues()
+	for i := 0; i < len(redirections); i++ {
+		operator := ""
+		if redirections[i].GetKind() == scrilaAst.StrLiteralNode {
+			operator = scrilaAst.ExprToStrLit(redirections[i]).GetValue()
+		}
+		if !slices.Contains(pipeRedirectOperators, operator) {
+			return NewNullVal(), fmt.Errorf("pipeRedirect() - Expected a redirection operator literal (one of %s) at index %d", strings.Join(pipeRedirectOperators, ", "), i)
+		}
+		if operator != "2>&1" {
+			i++
+			if i == len(redirections) {
+				return NewNullVal(), fmt.Errorf("pipeRedirect() - Redirection '%s' requires a file", operator)
+			}
+		}
+	}
+	return NewStrVal("str"), nil
+}
+
+func (self *Transpiler) inlinePipeRedirect(bashArgs []bashAst.IStatement) []bashAst.IStatement {
+	// The redirections are applied in the given order, e.g. "2>&1" before "> file" keeps stderr on stdout
+	values := bashAst.StmtToArray(bashArgs[1]).GetValues()
+	redirections := []bashAst.IRedirection{}
+	for i := 0; i < len(values); i++ {
+		operator := bashAst.StmtToStrLiteral(values[i]).GetValue()
+		if operator == "2>&1" {
+			redirections = append(redirections, bashAst.NewRedirection(operator, nil))
+			continue
+		}
+		i++
+		redirections = append(redirections, bashAst.NewRedirection(operator, values[i]))
+	}
+	return newPipelineStmts(bashArgs[0], true, redirections...)
+}
+
+// MARK: pipeToFile
+func (self *Transpiler) nativePipeToFile(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pipeToFile(str[] commands, str path)")
+	}
+	if err := self.validatePipelineCommands("pipeToFile", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("pipeToFile", "path", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	return NewNullVal(), nil
+}
+
+func (self *Transpiler) inlinePipeToFile(bashArgs []bashAst.IStatement) []bashAst.IStatement {
+	return newPipelineStmts(bashArgs[0], false, bashAst.NewRedirection(">", bashArgs[1]))
+}
+
+// MARK: pipeWithStderr
+func (self *Transpiler) nativePipeWithStderr(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pipeWithStderr(str[] commands)")
+	}
+	if err := self.validatePipelineCommands("pipeWithStderr", args[0], env); err != nil {
+		return NewNullVal(), err
+	}
+	return NewStrVal("str"), nil
+}
+
+func (self *Transpiler) inlinePipeWithStderr(bashArgs []bashAst.IStatement) []bashAst.IStatement {
+	return newPipelineStmts(bashArgs[0], true, bashAst.NewRedirection("2>&1", nil))
+}
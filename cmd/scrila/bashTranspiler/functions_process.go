@@ -0,0 +1,225 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+type execResultField struct {
+	name     string
+	dataType scrilaAst.NodeType
+}
+
+// Fields of the type "execResult" in the order in which they are stored in the Bash array
+var execResultFields = []execResultField{
+	{name: "stdout", dataType: scrilaAst.StrLiteralNode},
+	{name: "stderr", dataType: scrilaAst.StrLiteralNode},
+	{name: "code", dataType: scrilaAst.IntLiteralNode},
+	{name: "ok", dataType: scrilaAst.BoolLiteralNode},
+}
+
+// Returns the index of the given exec result field in the Bash array
+func getExecResultFieldIndex(fieldName string) (int, error) {
+	for i, field := range execResultFields {
+		if field.name == fieldName {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("Type 'execResult' has no field '%s'", fieldName)
+}
+
+// MARK: execFull
+func (self *Transpiler) nativeExecFull(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: execFull(str command)")
+	}
+	if err := self.validateArgType("execFull", "command", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for execFull to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "execFull") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "execFull")
+		// Stdout is captured by the command substitution and stderr is redirected into a temporary file.
+		// The result is returned as array with the fields "stdout", "stderr", "code" and "ok".
+		funcDecl := bashAst.NewFuncDeclaration("execFull", bashAst.ExecResultNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("command", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local stderrFile"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("stderrFile=\"$(mktemp)\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local stdout"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("stdout=\"$(eval \"${command}\" 2> \"${stderrFile}\")\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("execExitCode=$?"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local stderr"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("stderr=\"$(< \"${stderrFile}\")\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("rm -f \"${stderrFile}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local ok=\"false\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${execExitCode} -eq 0 ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tok=\"true\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs=(\"${stdout}\" \"${stderr}\" \"${execExitCode}\" \"${ok}\")"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return scrilaAst.NewRuntimeVal(scrilaAst.ExecResultValueType), nil
+}
+
+// MARK: execTimeout
+func (self *Transpiler) nativeExecTimeout(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: execTimeout(str command, int seconds)")
+	}
+	if err := self.validateArgType("execTimeout", "command", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("execTimeout", "seconds", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if args[1].GetKind() == scrilaAst.IntLiteralNode && scrilaAst.ExprToIntLit(args[1]).GetValue() <= 0 {
+		return NewNullVal(), fmt.Errorf("execTimeout() - Parameter seconds must be greater than 0")
+	}
+
+	// Add bash code for execTimeout to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "execTimeout") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "execTimeout")
+		// The command runs in a new shell as "timeout" can only execute programs. It exits with 124 if the time is up
+		// and with 137 if the command ignored SIGTERM and had to be killed. Both are reported as 124.
+		funcDecl := bashAst.NewFuncDeclaration("execTimeout", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("command", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("seconds", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"$(timeout --kill-after=1 \"${seconds}\" bash -c \"${command}\")\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("execExitCode=$?"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${execExitCode} -eq 137 ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\texecExitCode=124"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: lastExitCode
+func (self *Transpiler) nativeLastExitCode(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: lastExitCode()")
+	}
+
+	// Add bash code for lastExitCode to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "lastExitCode") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "lastExitCode")
+		funcDecl := bashAst.NewFuncDeclaration("lastExitCode", bashAst.IntLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=${execExitCode:-0}"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: run
+func (self *Transpiler) nativeRun(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: run(str[] argv)")
+	}
+	if err := self.validateArgType("run", "argv", args[0], scrilaAst.StrArrayNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if args[0].GetKind() == scrilaAst.ArrayLiteralNode && len(scrilaAst.ExprToArray(args[0]).GetValues()) == 0 {
+		return NewNullVal(), fmt.Errorf("run() - Parameter argv must contain at least the command")
+	}
+
+	// Add bash code for run to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "run") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "run")
+		// The arguments are passed to the command as they are without being parsed by the shell again.
+		// "command" skips functions of the script so that e.g. a user function "date" does not shadow the program.
+		funcDecl := bashAst.NewFuncDeclaration("run", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("argv", bashAst.StrArrayNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"$(command \"${argv[@]}\")\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("execExitCode=$?"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// Validates the args of the retry function with the given name and adds its bash code to the native body.
+// With backoff the delay is doubled after each failed attempt.
+func (self *Transpiler) nativeRetryWithDelay(funcName string, backoff bool, args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	// Validate args
+	if len(args) != 3 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: %s(int attempts, int delaySeconds, func fn)", funcName)
+	}
+	if err := self.validateArgType(funcName, "attempts", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if args[0].GetKind() == scrilaAst.IntLiteralNode && scrilaAst.ExprToIntLit(args[0]).GetValue() <= 0 {
+		return NewNullVal(), fmt.Errorf("%s() - Parameter attempts must be greater than 0", funcName)
+	}
+	if err := self.validateArgType(funcName, "delaySeconds", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	fn, err := self.validateCallbackArg(funcName, "fn", args[2], []scrilaAst.NodeType{}, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if err = validateCallbackReturnType(funcName, fn, scrilaAst.BoolLiteralNode); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for the retry function to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, funcName) {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, funcName)
+		// The callback can change "tmpIndex" so it is restored before the result is written
+		funcDecl := bashAst.NewFuncDeclaration(funcName, bashAst.BoolLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("attempts", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("delaySeconds", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("fn", bashAst.FuncRefNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local index=${tmpIndex}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local attempt"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("for ((attempt = 1; attempt <= attempts; attempt++))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\"${fn}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ \"${tmpBools[${tmpIndex}]}\" == \"true\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\ttmpIndex=${index}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\ttmpBools[${tmpIndex}]=\"true\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\treturn"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ ${attempt} -lt ${attempts} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tsleep \"${delaySeconds}\""))
+		if backoff {
+			funcDecl.AppendBody(bashAst.NewBashStmt("\t\tdelaySeconds=$((delaySeconds * 2))"))
+		}
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpIndex=${index}"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpBools[${tmpIndex}]=\"false\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: retry
+func (self *Transpiler) nativeRetry(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+	return self.nativeRetryWithDelay("retry", false, args, env)
+}
+
+// MARK: retryBackoff
+func (self *Transpiler) nativeRetryBackoff(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+	return self.nativeRetryWithDelay("retryBackoff", true, args, env)
+}
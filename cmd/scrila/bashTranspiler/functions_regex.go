@@ -0,0 +1,174 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Bash supports some GNU extensions which are not part of the POSIX ERE syntax.
+// They are replaced by equivalent expressions before validating a pattern.
+var gnuRegexExtensionsReplacer = strings.NewReplacer(
+	`\\`, `\\`,
+	`\w`, `[[:alnum:]_]`,
+	`\W`, `[^[:alnum:]_]`,
+	`\s`, `[[:space:]]`,
+	`\S`, `[^[:space:]]`,
+	`\b`, ``,
+	`\B`, ``,
+	`\<`, ``,
+	`\>`, ``,
+)
+
+// Validates the given pattern at compile time if it is a string literal
+func validateRegexArg(funcName string, arg scrilaAst.IExpr) error {
+	if arg.GetKind() != scrilaAst.StrLiteralNode {
+		return nil
+	}
+	pattern := bashDoubleQuotesReplacer.Replace(scrilaAst.ExprToStrLit(arg).GetValue())
+	if _, err := regexp.CompilePOSIX(gnuRegexExtensionsReplacer.Replace(pattern)); err != nil {
+		return fmt.Errorf("%s() - Parameter pattern is not a valid extended regular expression: %s", funcName, err)
+	}
+	return nil
+}
+
+// MARK: reFind
+func (self *Transpiler) nativeReFind(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: reFind(str value, str pattern)")
+	}
+	if err := self.validateArgType("reFind", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("reFind", "pattern", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := validateRegexArg("reFind", args[1]); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for reFind to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "reFind") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "reFind")
+		funcDecl := bashAst.NewFuncDeclaration("reFind", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("pattern", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${value} =~ ${pattern} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpStrs=(\"${BASH_REMATCH[@]}\")"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpStrs=()"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewArrayVal(scrilaAst.StrArrayValueType), nil
+}
+
+// MARK: reMatch
+func (self *Transpiler) nativeReMatch(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: reMatch(str value, str pattern)")
+	}
+	if err := self.validateArgType("reMatch", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("reMatch", "pattern", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := validateRegexArg("reMatch", args[1]); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for reMatch to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "reMatch") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "reMatch")
+		funcDecl := bashAst.NewFuncDeclaration("reMatch", bashAst.BoolLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("pattern", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${value} =~ ${pattern} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"true\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"false\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: reReplace
+func (self *Transpiler) nativeReReplace(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 3 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: reReplace(str value, str pattern, str replacement)")
+	}
+	if err := self.validateArgType("reReplace", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("reReplace", "pattern", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("reReplace", "replacement", args[2], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := validateRegexArg("reReplace", args[1]); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for reReplace to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "reReplace") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "reReplace")
+		// The pattern is extended by a group for the rest of the string to get the position of the match. Anchored patterns are only replaced once.
+		// Like in Go an empty match is also replaced at the end of the value but not directly after a previous match.
+		funcDecl := bashAst.NewFuncDeclaration("reReplace", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("pattern", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("replacement", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local regex=\"(${pattern})(.*)$\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local replaced=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local rest=\"${value}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local afterMatch=false"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local finished=false"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("while [[ ${finished} == false ]] && [[ ${rest} =~ ${regex} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tlocal match=\"${BASH_REMATCH[1]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tlocal suffix=\"${BASH_REMATCH[-1]}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tlocal prefix=\"${rest:0:${#rest}-${#BASH_REMATCH[0]}}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ -n \"${match}\" || -n \"${prefix}\" || ${afterMatch} == false ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\treplaced+=\"${prefix}${replacement}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tafterMatch=true"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ -z \"${match}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tafterMatch=false"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tif [[ -z \"${suffix}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\t\tfinished=true"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\treplaced+=\"${suffix:0:1}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tsuffix=\"${suffix:1}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\trest=\"${suffix}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tif [[ \"${pattern}\" == \"^\"* ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tthen"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tfinished=true"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tfi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${replaced}${rest}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
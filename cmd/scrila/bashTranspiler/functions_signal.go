@@ -0,0 +1,76 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Signals that can be handled or ignored. KILL and STOP can not be trapped by Bash.
+var signalNames = []string{"HUP", "INT", "QUIT", "TERM", "USR1", "USR2"}
+
+// Validates that the given arg is a string literal containing one of the given signal names.
+// The signal is checked at compile time as an unknown signal would only fail when the trap is set.
+func (self *Transpiler) validateSignalArg(funcName string, arg scrilaAst.IExpr, validSignals []string, env *Environment) error {
+	if err := self.validateArgType(funcName, "signal", arg, scrilaAst.StrLiteralNode, env); err != nil {
+		return err
+	}
+	if arg.GetKind() != scrilaAst.StrLiteralNode {
+		return fmt.Errorf("%s() - Parameter signal must be a literal", funcName)
+	}
+	signal := scrilaAst.ExprToStrLit(arg).GetValue()
+	if !slices.Contains(validSignals, signal) {
+		return fmt.Errorf("%s() - Invalid signal '%s'. Expected one of %s", funcName, signal, strings.Join(validSignals, ", "))
+	}
+	return nil
+}
+
+// MARK: ignoreSignal
+func (self *Transpiler) nativeIgnoreSignal(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: ignoreSignal(str signal)")
+	}
+	if err := self.validateSignalArg("ignoreSignal", args[0], signalNames, env); err != nil {
+		return NewNullVal(), err
+	}
+	return NewNullVal(), nil
+}
+
+func (self *Transpiler) inlineIgnoreSignal(bashArgs []bashAst.IStatement) []bashAst.IStatement {
+	signal := bashAst.StmtToStrLiteral(bashArgs[0]).GetValue()
+	return []bashAst.IStatement{bashAst.NewBashStmt(fmt.Sprintf("trap '' %s", signal))}
+}
+
+// MARK: onSignal
+func (self *Transpiler) nativeOnSignal(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: onSignal(str signal, func handler)")
+	}
+	// EXIT is not a real signal but allows to clean up whenever the script ends
+	if err := self.validateSignalArg("onSignal", args[0], append(slices.Clone(signalNames), "EXIT"), env); err != nil {
+		return NewNullVal(), err
+	}
+	fn, err := self.validateCallbackArg("onSignal", "handler", args[1], []scrilaAst.NodeType{}, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	if err = validateCallbackReturnType("onSignal", fn, scrilaAst.VoidNode); err != nil {
+		return NewNullVal(), err
+	}
+	return NewNullVal(), nil
+}
+
+func (self *Transpiler) inlineOnSignal(bashArgs []bashAst.IStatement) []bashAst.IStatement {
+	signal := bashAst.StmtToStrLiteral(bashArgs[0]).GetValue()
+	handler := bashAst.StmtToStrLiteral(bashArgs[1]).GetValue()
+	return []bashAst.IStatement{bashAst.NewBashStmt(fmt.Sprintf("trap '%s' %s", handler, signal))}
+}
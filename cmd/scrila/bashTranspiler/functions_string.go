@@ -0,0 +1,482 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// MARK: strCount
+func (self *Transpiler) nativeStrCount(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strCount(str value, str substring)")
+	}
+	if err := self.validateArgType("strCount", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strCount", "substring", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strCount to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strCount") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strCount")
+		funcDecl := bashAst.NewFuncDeclaration("strCount", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("substring", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -z \"${substring}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpInts[${tmpIndex}]=0"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\treturn"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local rest=\"${value//\"${substring}\"}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=$(( (${#value} - ${#rest}) / ${#substring} ))"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: strIndexOf
+func (self *Transpiler) nativeStrIndexOf(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strIndexOf(str value, str substring)")
+	}
+	if err := self.validateArgType("strIndexOf", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strIndexOf", "substring", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strIndexOf to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strIndexOf") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strIndexOf")
+		funcDecl := bashAst.NewFuncDeclaration("strIndexOf", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("substring", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ \"${value}\" != *\"${substring}\"* ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpInts[${tmpIndex}]=-1"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\treturn"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local prefix=\"${value%%\"${substring}\"*}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=${#prefix}"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: strLastIndexOf
+func (self *Transpiler) nativeStrLastIndexOf(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strLastIndexOf(str value, str substring)")
+	}
+	if err := self.validateArgType("strLastIndexOf", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strLastIndexOf", "substring", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strLastIndexOf to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strLastIndexOf") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strLastIndexOf")
+		funcDecl := bashAst.NewFuncDeclaration("strLastIndexOf", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("substring", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ \"${value}\" != *\"${substring}\"* ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpInts[${tmpIndex}]=-1"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\treturn"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local prefix=\"${value%\"${substring}\"*}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=${#prefix}"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: strLen
+func (self *Transpiler) nativeStrLen(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strLen(str value)")
+	}
+	if err := self.validateArgType("strLen", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strLen to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strLen") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strLen")
+		funcDecl := bashAst.NewFuncDeclaration("strLen", bashAst.IntLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=${#value}"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: strPadLeft
+func (self *Transpiler) nativeStrPadLeft(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 3 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strPadLeft(str value, int length, str padding)")
+	}
+	if err := self.validateArgType("strPadLeft", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strPadLeft", "length", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strPadLeft", "padding", args[2], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strPadLeft to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strPadLeft") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strPadLeft")
+		funcDecl := bashAst.NewFuncDeclaration("strPadLeft", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("length", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("padding", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local pad=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -n \"${padding}\" ]] && [[ ${#value} -lt ${length} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\twhile [[ $(( ${#pad} + ${#value} )) -lt ${length} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tdo"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tpad+=\"${padding}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tdone"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tpad=\"${pad:0:$(( ${length} - ${#value} ))}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${pad}${value}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: strPadRight
+func (self *Transpiler) nativeStrPadRight(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 3 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strPadRight(str value, int length, str padding)")
+	}
+	if err := self.validateArgType("strPadRight", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strPadRight", "length", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strPadRight", "padding", args[2], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strPadRight to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strPadRight") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strPadRight")
+		funcDecl := bashAst.NewFuncDeclaration("strPadRight", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("length", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("padding", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local pad=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -n \"${padding}\" ]] && [[ ${#value} -lt ${length} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\twhile [[ $(( ${#pad} + ${#value} )) -lt ${length} ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tdo"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\t\tpad+=\"${padding}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tdone"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\tpad=\"${pad:0:$(( ${length} - ${#value} ))}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${value}${pad}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: strRepeat
+func (self *Transpiler) nativeStrRepeat(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strRepeat(str value, int count)")
+	}
+	if err := self.validateArgType("strRepeat", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strRepeat", "count", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strRepeat to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strRepeat") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strRepeat")
+		funcDecl := bashAst.NewFuncDeclaration("strRepeat", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("count", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local repeated=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local i"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("for (( i=0; i<${count}; i++ ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\trepeated+=\"${value}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${repeated}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: strReplace
+func (self *Transpiler) nativeStrReplace(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 3 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strReplace(str value, str search, str replacement)")
+	}
+	if err := self.validateArgType("strReplace", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strReplace", "search", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strReplace", "replacement", args[2], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strReplace to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strReplace") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strReplace")
+		funcDecl := bashAst.NewFuncDeclaration("strReplace", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("search", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("replacement", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${value/\"${search}\"/\"${replacement}\"}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: strReplaceAll
+func (self *Transpiler) nativeStrReplaceAll(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 3 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strReplaceAll(str value, str search, str replacement)")
+	}
+	if err := self.validateArgType("strReplaceAll", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strReplaceAll", "search", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strReplaceAll", "replacement", args[2], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strReplaceAll to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strReplaceAll") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strReplaceAll")
+		funcDecl := bashAst.NewFuncDeclaration("strReplaceAll", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("search", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("replacement", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${value//\"${search}\"/\"${replacement}\"}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: strReverse
+func (self *Transpiler) nativeStrReverse(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strReverse(str value)")
+	}
+	if err := self.validateArgType("strReverse", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strReverse to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strReverse") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strReverse")
+		funcDecl := bashAst.NewFuncDeclaration("strReverse", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local reversed=\"\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local i"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("for (( i=${#value}-1; i>=0; i-- ))"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("do"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\treversed+=\"${value:i:1}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("done"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${reversed}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: strSub
+func (self *Transpiler) nativeStrSub(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 3 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strSub(str value, int start, int length)")
+	}
+	if err := self.validateArgType("strSub", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strSub", "start", args[1], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("strSub", "length", args[2], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strSub to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strSub") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strSub")
+		funcDecl := bashAst.NewFuncDeclaration("strSub", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("start", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("length", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${value:${start}:${length}}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: strToLower
+func (self *Transpiler) nativeStrToLower(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strToLower(str value)")
+	}
+	if err := self.validateArgType("strToLower", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strToLower to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strToLower") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strToLower")
+		funcDecl := bashAst.NewFuncDeclaration("strToLower", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${value,,}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: strToUpper
+func (self *Transpiler) nativeStrToUpper(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strToUpper(str value)")
+	}
+	if err := self.validateArgType("strToUpper", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strToUpper to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strToUpper") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strToUpper")
+		funcDecl := bashAst.NewFuncDeclaration("strToUpper", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${value^^}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: strTrim
+func (self *Transpiler) nativeStrTrim(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strTrim(str value)")
+	}
+	if err := self.validateArgType("strTrim", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strTrim to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strTrim") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strTrim")
+		funcDecl := bashAst.NewFuncDeclaration("strTrim", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("local trimmed=\"${value#\"${value%%[![:space:]]*}\"}\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${trimmed%\"${trimmed##*[![:space:]]}\"}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: strTrimLeft
+func (self *Transpiler) nativeStrTrimLeft(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strTrimLeft(str value)")
+	}
+	if err := self.validateArgType("strTrimLeft", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strTrimLeft to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strTrimLeft") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strTrimLeft")
+		funcDecl := bashAst.NewFuncDeclaration("strTrimLeft", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${value#\"${value%%[![:space:]]*}\"}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: strTrimRight
+func (self *Transpiler) nativeStrTrimRight(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: strTrimRight(str value)")
+	}
+	if err := self.validateArgType("strTrimRight", "value", args[0], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for strTrimRight to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "strTrimRight") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "strTrimRight")
+		funcDecl := bashAst.NewFuncDeclaration("strTrimRight", bashAst.StrLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("value", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${value%\"${value##*[![:space:]]}\"}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
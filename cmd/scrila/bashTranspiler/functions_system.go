@@ -0,0 +1,226 @@
+package bashTranspiler
+
+import (
+	"ScriLa/cmd/scrila/bashAst"
+	"ScriLa/cmd/scrila/scrilaAst"
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// MARK: cpuCount
+func (self *Transpiler) nativeCpuCount(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: cpuCount()")
+	}
+
+	// Add bash code for cpuCount to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "cpuCount") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "cpuCount")
+		funcDecl := bashAst.NewFuncDeclaration("cpuCount", bashAst.IntLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=$(grep -c '^processor' /proc/cpuinfo)"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: hostname
+func (self *Transpiler) nativeHostname(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: hostname()")
+	}
+
+	// Add bash code for hostname to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "hostname") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "hostname")
+		funcDecl := bashAst.NewFuncDeclaration(nativeBashFuncName("hostname"), bashAst.StrLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"${HOSTNAME}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: isRoot
+func (self *Transpiler) nativeIsRoot(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: isRoot()")
+	}
+
+	// Add bash code for isRoot to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "isRoot") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "isRoot")
+		// The effective user id is used as it decides about the permissions (e.g. when started with sudo)
+		funcDecl := bashAst.NewFuncDeclaration("isRoot", bashAst.BoolLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ ${EUID} -eq 0 ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"true\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"false\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: killProcess
+func (self *Transpiler) nativeKillProcess(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Signals that can be sent to a process in addition to the ones that can be handled by a script
+	killSignalNames := append(slices.Clone(signalNames), "KILL", "STOP", "CONT")
+
+	// Validate args
+	if len(args) != 2 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: killProcess(int pid, str signal)")
+	}
+	if err := self.validateArgType("killProcess", "pid", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if err := self.validateArgType("killProcess", "signal", args[1], scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+	if args[1].GetKind() == scrilaAst.StrLiteralNode {
+		signal := scrilaAst.ExprToStrLit(args[1]).GetValue()
+		if !slices.Contains(killSignalNames, signal) {
+			return NewNullVal(), fmt.Errorf("killProcess() - Invalid signal '%s'. Expected one of %s", signal, strings.Join(killSignalNames, ", "))
+		}
+	}
+
+	// Add bash code for killProcess to "usedNativeFunctions"
+	self.appendNativeErrorFunc()
+	if !slices.Contains(self.usedNativeFunctions, "killProcess") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "killProcess")
+		funcDecl := bashAst.NewFuncDeclaration("killProcess", bashAst.VoidNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("pid", bashAst.IntLiteralNode))
+		funcDecl.AppendParams(bashAst.NewFuncParameter("signal", bashAst.StrLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("if [[ \" %s \" != *\" ${signal} \"* ]]", strings.Join(killSignalNames, " "))))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt(fmt.Sprintf("\tnativeError \"killProcess() - Invalid signal '${signal}'. Expected one of %s\"", strings.Join(killSignalNames, ", "))))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("kill -s \"${signal}\" \"${pid}\" 2> /dev/null || nativeError \"killProcess() - Cannot send signal '${signal}' to process ${pid}\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewNullVal(), nil
+}
+
+// MARK: osName
+func (self *Transpiler) nativeOsName(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: osName()")
+	}
+
+	// Add bash code for osName to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "osName") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "osName")
+		// The name of the distribution is only known on Linux. Other systems return the name of the kernel.
+		funcDecl := bashAst.NewFuncDeclaration("osName", bashAst.StrLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -r /etc/os-release ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpStrs[${tmpIndex}]=\"$(. /etc/os-release && printf '%s' \"${NAME}\")\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpStrs[${tmpIndex}]=\"$(uname -s)\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
+
+// MARK: pid
+func (self *Transpiler) nativePid(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: pid()")
+	}
+
+	// Add bash code for pid to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "pid") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "pid")
+		// "$$" is the process id of the script even inside of a background job
+		funcDecl := bashAst.NewFuncDeclaration("pid", bashAst.IntLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=$$"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: ppid
+func (self *Transpiler) nativePpid(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: ppid()")
+	}
+
+	// Add bash code for ppid to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "ppid") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "ppid")
+		funcDecl := bashAst.NewFuncDeclaration("ppid", bashAst.IntLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=${PPID}"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewIntVal(1), nil
+}
+
+// MARK: processRunning
+func (self *Transpiler) nativeProcessRunning(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 1 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: processRunning(int pid)")
+	}
+	if err := self.validateArgType("processRunning", "pid", args[0], scrilaAst.IntLiteralNode, env); err != nil {
+		return NewNullVal(), err
+	}
+
+	// Add bash code for processRunning to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "processRunning") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "processRunning")
+		// Unlike "kill -0" this also works for processes of other users
+		funcDecl := bashAst.NewFuncDeclaration("processRunning", bashAst.BoolLiteralNode)
+		funcDecl.AppendParams(bashAst.NewFuncParameter("pid", bashAst.IntLiteralNode))
+		funcDecl.AppendBody(bashAst.NewBashStmt("if [[ -d \"/proc/${pid}\" ]]"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("then"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"true\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("else"))
+		funcDecl.AppendBody(bashAst.NewBashStmt("\ttmpBools[${tmpIndex}]=\"false\""))
+		funcDecl.AppendBody(bashAst.NewBashStmt("fi"))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewBoolVal(true), nil
+}
+
+// MARK: whoami
+func (self *Transpiler) nativeWhoami(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Validate args
+	if len(args) != 0 {
+		return NewNullVal(), fmt.Errorf("Expected syntax: whoami()")
+	}
+
+	// Add bash code for whoami to "usedNativeFunctions"
+	if !slices.Contains(self.usedNativeFunctions, "whoami") {
+		self.usedNativeFunctions = append(self.usedNativeFunctions, "whoami")
+		funcDecl := bashAst.NewFuncDeclaration(nativeBashFuncName("whoami"), bashAst.StrLiteralNode)
+		funcDecl.AppendBody(bashAst.NewBashStmt("tmpStrs[${tmpIndex}]=\"$(id -un)\""))
+		self.bashProgram.AppendNativeBody(funcDecl)
+	}
+	return NewStrVal("str"), nil
+}
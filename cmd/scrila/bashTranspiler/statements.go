@@ -4,6 +4,8 @@ import (
 	"ScriLa/cmd/scrila/bashAst"
 	"ScriLa/cmd/scrila/scrilaAst"
 	"fmt"
+
+	"golang.org/x/exp/slices"
 )
 
 func (self *Transpiler) evalProgram(program scrilaAst.IProgram, env *Environment) (scrilaAst.IRuntimeVal, error) {
@@ -18,9 +20,40 @@ func (self *Transpiler) evalProgram(program scrilaAst.IProgram, env *Environment
 		}
 	}
 
+	self.appendFlagParser()
+	if err := self.appendEntryPointCall(program); err != nil {
+		return NewNullVal(), err
+	}
+
 	return lastEvaluated, nil
 }
 
+// If the program declares the entry point "func main(str[] args) int", it is called with the arguments of the script.
+// Its return value is used as exit code.
+func (self *Transpiler) appendEntryPointCall(program scrilaAst.IProgram) error {
+	for _, statement := range program.GetBody() {
+		if statement.GetKind() != scrilaAst.FunctionDeclarationNode {
+			continue
+		}
+		funcDeclaration := scrilaAst.ExprToFuncDecl(statement)
+		if funcDeclaration.GetName() != "main" {
+			continue
+		}
+
+		params := funcDeclaration.GetParameters()
+		if len(params) != 1 || params[0].GetParamType() != scrilaAst.StrArrayNode || funcDeclaration.GetReturnType() != scrilaAst.IntLiteralNode {
+			return fmt.Errorf("%s: The entry point must be declared as 'func main(str[] args) int'", self.getPos(funcDeclaration))
+		}
+
+		self.appendUserBody(bashAst.NewComment("Call the entry point with the arguments of the script"))
+		self.appendUserBody(bashAst.NewBashStmt("tmpIndex=0"))
+		self.appendUserBody(bashAst.NewBashStmt("main \"$@\""))
+		self.appendUserBody(bashAst.NewBashStmt("exit \"${tmpInts[${tmpIndex}]}\""))
+		return nil
+	}
+	return nil
+}
+
 func (self *Transpiler) evalVarDeclaration(varDeclaration scrilaAst.IVarDeclaration, env *Environment) (scrilaAst.IRuntimeVal, error) {
 	self.printFuncName("")
 
@@ -34,7 +67,8 @@ func (self *Transpiler) evalVarDeclaration(varDeclaration scrilaAst.IVarDeclarat
 	if err != nil {
 		return NewNullVal(), err
 	}
-	if !doMatch {
+	isPromoted := isIntPromotedToFloat(varDeclaration.GetDataType(), givenType)
+	if !doMatch && !isPromoted {
 		return NewNullVal(), fmt.Errorf("%s: Cannot assign a value of type '%s' to a var of type '%s'", self.getPos(varDeclaration.GetValue()), givenType, varDeclaration.GetDataType())
 	}
 
@@ -47,6 +81,9 @@ func (self *Transpiler) evalVarDeclaration(varDeclaration scrilaAst.IVarDeclarat
 	if err != nil {
 		return NewNullVal(), err
 	}
+	if isPromoted {
+		bashStmt = self.intToFloatBashStmt(bashStmt)
+	}
 	self.appendUserBody(bashAst.NewAssignmentExpr(
 		bashAst.NewVarLiteral(varDeclaration.GetIdentifier(), bashVarType),
 		bashStmt,
@@ -63,6 +100,18 @@ func (self *Transpiler) evalVarDeclaration(varDeclaration scrilaAst.IVarDeclarat
 func (self *Transpiler) evalForStatement(forStmt scrilaAst.IForStatement, env *Environment) (scrilaAst.IRuntimeVal, error) {
 	self.printFuncName("")
 
+	if forStmt.IsParallel() {
+		return self.evalParallelForStatement(forStmt, env)
+	}
+
+	// Lines of a file or command output are streamed instead of being stored in an array
+	if forStmt.GetArray().GetKind() == scrilaAst.CallExprNode {
+		caller := scrilaAst.ExprToCallExpr(forStmt.GetArray()).GetCaller()
+		if caller.GetKind() == scrilaAst.IdentifierNode && slices.Contains([]string{"execLines", "lines"}, identNodeGetSymbol(caller)) {
+			return self.evalForLinesStatement(forStmt, env)
+		}
+	}
+
 	// Index
 	varType := forStmt.GetIndexVarType()
 	varName := forStmt.GetIndex().GetSymbol()
@@ -111,6 +160,164 @@ func (self *Transpiler) evalForStatement(forStmt scrilaAst.IForStatement, env *E
 	return NewNullVal(), err
 }
 
+func (self *Transpiler) evalForLinesStatement(forStmt scrilaAst.IForStatement, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	callExpr := scrilaAst.ExprToCallExpr(forStmt.GetArray())
+	funcName := identNodeGetSymbol(callExpr.GetCaller())
+	paramName := "file"
+	if funcName == "execLines" {
+		paramName = "command"
+	}
+
+	// Validate args
+	if len(callExpr.GetArgs()) != 1 {
+		return NewNullVal(), fmt.Errorf("%s: Expected syntax: %s(str %s)", self.getPos(callExpr), funcName, paramName)
+	}
+	source := callExpr.GetArgs()[0]
+	self.pushCallArgIndex()
+	_, err := self.transpile(source, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	self.popCallArgIndex()
+	if err := self.validateArgType(funcName, paramName, source, scrilaAst.StrLiteralNode, env); err != nil {
+		return NewNullVal(), fmt.Errorf("%s: %s", self.getPos(callExpr), err)
+	}
+	bashSource, err := self.exprToRhsBashStmt(source, env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+
+	// Index
+	varType := forStmt.GetIndexVarType()
+	varName := forStmt.GetIndex().GetSymbol()
+	if varType != scrilaAst.StrLiteralNode {
+		return NewNullVal(), fmt.Errorf("%s: The variable for the lines of %s() must be of type 'StrLiteral'. Got '%s'", self.getPos(forStmt), funcName, varType)
+	}
+
+	localEnv := NewEnvironment(env, self)
+	_, err = localEnv.declareVar(varName, false, varType)
+	if err != nil {
+		return NewNullVal(), fmt.Errorf("%s: %s", self.getPos(forStmt), err)
+	}
+
+	self.pushContext(ForLoopContext)
+	self.pushBashContext(bashAst.NewWhileReadStmt(bashAst.NewVarLiteral(varName, bashAst.StrLiteralNode), bashSource, funcName == "execLines"))
+
+	// Transpile the body line by line
+	err = self.evalStatementBody(forStmt.GetBody(), localEnv)
+	if err != nil {
+		return NewNullVal(), err
+	}
+
+	whileReadStmt := self.currentBashContext()
+	self.popContext()
+	self.popBashContext()
+	self.appendUserBody(whileReadStmt)
+
+	return NewNullVal(), nil
+}
+
+// The body of a parallel for loop is transpiled to a Bash function.
+// The native Bash function "parallelFor" calls it for each value as background job.
+func (self *Transpiler) evalParallelForStatement(forStmt scrilaAst.IForStatement, env *Environment) (scrilaAst.IRuntimeVal, error) {
+	self.printFuncName("")
+
+	// Index
+	varType := forStmt.GetIndexVarType()
+	varName := forStmt.GetIndex().GetSymbol()
+
+	localEnv := NewEnvironment(env, self)
+
+	_, err := localEnv.declareVar(varName, false, varType)
+	if err != nil {
+		return NewNullVal(), fmt.Errorf("%s: %s", self.getPos(forStmt), err)
+	}
+	bashVarType, err := scrilaNodeTypeToBashNodeType(varType)
+	if err != nil {
+		return NewNullVal(), fmt.Errorf("%s: %s", self.getPos(forStmt), err)
+	}
+
+	// Array and limit are passed to "parallelFor" like the args of a function call
+	self.pushCallArgIndex()
+	_, err = self.transpile(forStmt.GetArray(), env)
+	if err != nil {
+		return NewNullVal(), fmt.Errorf("%s: %s", self.getPos(forStmt), err)
+	}
+	doMatch, err := self.exprIsArray(forStmt.GetArray(), varType, localEnv)
+	if err != nil {
+		return NewNullVal(), fmt.Errorf("%s: %s", self.getPos(forStmt), err)
+	}
+	if !doMatch {
+		return NewNullVal(), fmt.Errorf("%s: Array data type and index data type is not matching", self.getPos(forStmt))
+	}
+	bashArrayStmt, err := self.exprToRhsBashStmt(forStmt.GetArray(), env)
+	if err != nil {
+		return NewNullVal(), err
+	}
+	self.incCallArgIndex()
+
+	var bashLimit bashAst.IStatement = bashAst.NewIntLiteral(0)
+	if forStmt.GetLimit().GetKind() != scrilaAst.ExprNode {
+		_, err = self.transpile(forStmt.GetLimit(), env)
+		if err != nil {
+			return NewNullVal(), err
+		}
+		doMatch, givenType, err := self.exprIsType(forStmt.GetLimit(), scrilaAst.IntLiteralNode, env)
+		if err != nil {
+			return NewNullVal(), err
+		}
+		if !doMatch {
+			return NewNullVal(), fmt.Errorf("%s: Limit of a parallel for loop must be an int. Got '%s'", self.getPos(forStmt.GetLimit()), givenType)
+		}
+		if forStmt.GetLimit().GetKind() == scrilaAst.IntLiteralNode && scrilaAst.ExprToIntLit(forStmt.GetLimit()).GetValue() <= 0 {
+			return NewNullVal(), fmt.Errorf("%s: Limit of a parallel for loop must be greater than 0", self.getPos(forStmt.GetLimit()))
+		}
+		bashLimit, err = self.exprToRhsBashStmt(forStmt.GetLimit(), env)
+		if err != nil {
+			return NewNullVal(), err
+		}
+	}
+	self.popCallArgIndex()
+
+	if capturedVar := findChangedCapturedVar(forStmt.GetBody(), []string{varName}, localEnv); capturedVar != "" {
+		return NewNullVal(), fmt.Errorf("%s: Parallel for loop must not change the captured variable '%s' as each iteration runs in a background job", self.getPos(forStmt), capturedVar)
+	}
+
+	self.parallelForCount++
+	bodyFuncName := fmt.Sprintf("parallelForBody%d", self.parallelForCount)
+	bodyFunc := bashAst.NewFuncDeclaration(bodyFuncName, bashAst.VoidNode)
+	bodyFunc.AppendParams(bashAst.NewFuncParameter(varName, bashVarType))
+
+	// The body runs in a subshell so the written index of the surrounding code is unknown inside and not changed after it
+	lastWrittenIndex := self.lastWrittenIndex
+	self.lastWrittenIndex = -1
+	self.pushContext(ParallelContext)
+	self.pushBashContext(bodyFunc)
+
+	// Transpile the body line by line
+	err = self.evalStatementBody(forStmt.GetBody(), localEnv)
+	if err != nil {
+		return NewNullVal(), err
+	}
+
+	self.popContext()
+	self.popBashContext()
+	self.lastWrittenIndex = lastWrittenIndex
+
+	self.appendParallelForFunc()
+	self.appendUserBody(bodyFunc)
+	self.appendUserBody(bashAst.NewCallExpr("parallelFor", []bashAst.IStatement{
+		bashLimit,
+		bashAst.NewBoolLiteral(forStmt.IsFailFast()),
+		bashAst.NewStrLiteral(bodyFuncName),
+		bashArrayStmt,
+	}))
+
+	return NewNullVal(), nil
+}
+
 func (self *Transpiler) evalIfStatement(ifStatement scrilaAst.IIfStatement, env *Environment) (scrilaAst.IRuntimeVal, error) {
 	self.printFuncName("")
 
@@ -325,6 +532,13 @@ func (self *Transpiler) evalFunctionDeclaration(funcDeclaration scrilaAst.IFunct
 			return NewNullVal(), err
 		}
 	}
+	// The entry point exits with 0 if it ends without a return. Otherwise the exit code would be the
+	// value that the last native function stored in "tmpInts".
+	body := fn.GetBody()
+	if funcDeclaration.GetName() == "main" && funcDeclaration.GetReturnType() == scrilaAst.IntLiteralNode &&
+		(len(body) == 0 || body[len(body)-1].GetKind() != scrilaAst.ReturnExprNode) {
+		self.appendUserBody(bashAst.NewBashStmt("tmpInts[${tmpIndex}]=0"))
+	}
 	self.popContext()
 	self.bashProgram.AppendUserBody(self.currentBashFunc)
 	self.currentBashFunc = nil
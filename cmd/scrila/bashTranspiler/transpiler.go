@@ -17,6 +17,7 @@ const (
 	NoContext        Context = "NoContext"
 	FunctionContext  Context = "FunctionContext"
 	ForLoopContext   Context = "ForLoopContext"
+	ParallelContext  Context = "ParallelContext"
 	WhileLoopContext Context = "WhileLoopContext"
 	IfStmtContext    Context = "IfStmtContext"
 )
@@ -37,6 +38,19 @@ type Transpiler struct {
 	// Used to only write index changes to Bash file
 	lastWrittenIndex int
 
+	// Stores the flags declared with the flag native functions to generate the option parser
+	flags []*flagDeclaration
+
+	// Stores the temporary array receiving the result of each spawned function
+	spawnResultVars map[string]string
+	// Counts the parallel for loops to give each loop body a unique Bash function name
+	parallelForCount int
+
+	// Stores the names of all declared variables and of the environment variables given as literal to the env
+	// native functions so that a ScriLa variable cannot change an environment variable
+	varNames    []string
+	envVarNames []string
+
 	// Storage for the Bash statements that are used later e.g for assignments
 	bashStmtStack map[int]bashAst.IStatement
 
@@ -51,6 +65,7 @@ func NewTranspiler() *Transpiler {
 		bashStmtStack:       make(map[int]bashAst.IStatement),
 		callArgIndexStack:   []int{},
 		lastWrittenIndex:    -1,
+		spawnResultVars:     make(map[string]string),
 	}
 }
 
@@ -70,6 +85,8 @@ func (self *Transpiler) transpile(astNode scrilaAst.IStatement, env *Environment
 	// Handle Expressions
 	case scrilaAst.ArrayLiteralNode:
 		return self.evalArray(scrilaAst.ExprToArray(astNode), env)
+	case scrilaAst.FloatLiteralNode:
+		return NewFloatVal(scrilaAst.ExprToFloatLit(astNode).GetValue()), nil
 	case scrilaAst.IntLiteralNode:
 		return NewIntVal(scrilaAst.ExprToIntLit(astNode).GetValue()), nil
 	case scrilaAst.StrLiteralNode:
@@ -143,6 +160,16 @@ func (self *Transpiler) contextContains(context Context) bool {
 	return slices.Contains(self.contexts, context)
 }
 
+// Returns the most recently pushed context of the given contexts or "NoContext" if none of them is pushed
+func (self *Transpiler) innermostContext(contexts ...Context) Context {
+	for i := len(self.contexts) - 1; i >= 0; i-- {
+		if slices.Contains(contexts, self.contexts[i]) {
+			return self.contexts[i]
+		}
+	}
+	return NoContext
+}
+
 func (self *Transpiler) pushCallArgIndex() {
 	self.callArgIndexStack = append(self.callArgIndexStack, 0)
 }
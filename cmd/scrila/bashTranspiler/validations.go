@@ -4,6 +4,8 @@ import (
 	"ScriLa/cmd/scrila/bashAst"
 	"ScriLa/cmd/scrila/scrilaAst"
 	"fmt"
+
+	"golang.org/x/exp/slices"
 )
 
 func (self *Transpiler) exprIsArray(expr scrilaAst.IExpr, wantedArrayType scrilaAst.NodeType, env *Environment) (bool, error) {
@@ -27,6 +29,9 @@ func (self *Transpiler) exprIsArray(expr scrilaAst.IExpr, wantedArrayType scrila
 		if err != nil {
 			return false, err
 		}
+		if !scrilaNodeTypeIsArray(givenArrayType) {
+			return false, nil
+		}
 		givenType, err := scrilaAst.ArrayTypeToDataType(givenArrayType)
 		if err != nil {
 			return false, err
@@ -35,9 +40,41 @@ func (self *Transpiler) exprIsArray(expr scrilaAst.IExpr, wantedArrayType scrila
 		return givenType == wantedArrayType, nil
 	}
 
+	// Check if the return type of the function call is an array of the wanted type
+	if expr.GetKind() == scrilaAst.CallExprNode {
+		returnType, err := self.getFuncReturnType(scrilaAst.ExprToCallExpr(expr), env)
+		if err != nil {
+			return false, err
+		}
+		if !scrilaNodeTypeIsArray(returnType) {
+			return false, nil
+		}
+		givenType, err := scrilaAst.ArrayTypeToDataType(returnType)
+		if err != nil {
+			return false, err
+		}
+
+		return givenType == wantedArrayType, nil
+	}
+
 	return false, nil
 }
 
+// Returns the data type of the given array e.g. 'IntLiteral' for an 'IntArray'.
+// The second return value is false if the expression is not a typed array.
+func (self *Transpiler) exprArrayDataType(expr scrilaAst.IExpr, env *Environment) (scrilaAst.NodeType, bool, error) {
+	for _, dataType := range []scrilaAst.NodeType{scrilaAst.BoolLiteralNode, scrilaAst.FloatLiteralNode, scrilaAst.IntLiteralNode, scrilaAst.StrLiteralNode} {
+		isArray, err := self.exprIsArray(expr, dataType, env)
+		if err != nil {
+			return "", false, err
+		}
+		if isArray {
+			return dataType, true, nil
+		}
+	}
+	return "", false, nil
+}
+
 func (self *Transpiler) exprIsType(expr scrilaAst.IExpr, wantedType scrilaAst.NodeType, env *Environment) (bool, scrilaAst.NodeType, error) {
 	givenType := expr.GetKind()
 	// Check types directly
@@ -81,6 +118,12 @@ func (self *Transpiler) exprIsType(expr scrilaAst.IExpr, wantedType scrilaAst.No
 			return false, givenType, err
 		}
 
+		// The field index defines the type of an exec result field
+		if varType == scrilaAst.ExecResultNode {
+			varType = execResultFields[bashAst.StmtToIntLiteral(memberExpr.GetIndex()).GetValue()].dataType
+			return varType == wantedType, varType, nil
+		}
+
 		varType, err = scrilaAst.ArrayTypeToDataType(varType)
 		if err != nil {
 			return false, givenType, err
@@ -140,3 +183,162 @@ func (self *Transpiler) exprIsType(expr scrilaAst.IExpr, wantedType scrilaAst.No
 
 	return false, givenType, nil
 }
+
+// Checks if the given expr is an identifier referencing a user defined function (e.g. a callback) and not a variable
+func (self *Transpiler) exprIsFuncRef(expr scrilaAst.IExpr, env *Environment) bool {
+	if expr.GetKind() != scrilaAst.IdentifierNode {
+		return false
+	}
+	symbol := identNodeGetSymbol(expr)
+	if _, err := env.resolve(symbol); err == nil {
+		return false
+	}
+	caller, err := env.lookupFunc(symbol)
+	if err != nil {
+		return false
+	}
+	return caller.GetType() == scrilaAst.FunctionValueType
+}
+
+var argTypeDescriptionMapping = map[scrilaAst.NodeType]string{
+	scrilaAst.BoolLiteralNode:  "a bool or a variable of type bool",
+	scrilaAst.FloatLiteralNode: "a float or a variable of type float",
+	scrilaAst.IntLiteralNode:   "an int or a variable of type int",
+	scrilaAst.JobArrayNode:     "an array of jobs or a variable of type job[]",
+	scrilaAst.JobNode:          "a variable of type job",
+	scrilaAst.StrLiteralNode:   "a string or a variable of type string",
+	scrilaAst.StrArrayNode:     "an array of strings or a variable of type str[]",
+}
+
+// Validates that the given arg of a native function is of the wanted type
+func (self *Transpiler) validateArgType(funcName string, paramName string, arg scrilaAst.IExpr, wantedType scrilaAst.NodeType, env *Environment) error {
+	doMatch, givenType, err := self.exprIsType(arg, wantedType, env)
+	if err != nil {
+		return err
+	}
+	if !doMatch {
+		return fmt.Errorf("%s() - Parameter %s must be %s. Got '%s'", funcName, paramName, argTypeDescriptionMapping[wantedType], givenType)
+	}
+	return nil
+}
+
+// Returns the name of the first variable declared outside of the given body that is changed inside of it
+// or inside of a user defined function called by it.
+// Used for code running in a subshell (e.g. a background job) where these changes would be lost silently.
+func findChangedCapturedVar(body []scrilaAst.IStatement, localVars []string, env *Environment) string {
+	return findChangedCapturedVarInBody(body, localVars, env, map[string]bool{})
+}
+
+// The visited functions are only checked once so that recursive functions do not loop forever
+func findChangedCapturedVarInBody(body []scrilaAst.IStatement, localVars []string, env *Environment, visitedFuncs map[string]bool) string {
+	for _, stmt := range body {
+		varName := ""
+		switch stmt.GetKind() {
+		case scrilaAst.VarDeclarationNode:
+			localVars = append(localVars, scrilaAst.ExprToVarDecl(stmt).GetIdentifier())
+		case scrilaAst.AssignmentExprNode:
+			assigne := scrilaAst.ExprToAssignmentExpr(stmt).GetAssigne()
+			for assigne.GetKind() == scrilaAst.MemberExprNode {
+				assigne = scrilaAst.ExprToMemberExpr(assigne).GetObject()
+			}
+			if assigne.GetKind() == scrilaAst.IdentifierNode && !slices.Contains(localVars, identNodeGetSymbol(assigne)) {
+				varName = identNodeGetSymbol(assigne)
+			}
+		case scrilaAst.ForStatementNode:
+			forStmt := scrilaAst.ExprToForStmt(stmt)
+			varName = findChangedCapturedVarInBody(forStmt.GetBody(), append(slices.Clone(localVars), forStmt.GetIndex().GetSymbol()), env, visitedFuncs)
+		case scrilaAst.IfStatementNode:
+			for ifStmt := scrilaAst.ExprToIfStmt(stmt); ifStmt != nil && varName == ""; ifStmt = ifStmt.GetElse() {
+				varName = findChangedCapturedVarInBody(ifStmt.GetBody(), slices.Clone(localVars), env, visitedFuncs)
+			}
+		case scrilaAst.WhileStatementNode:
+			varName = findChangedCapturedVarInBody(scrilaAst.ExprToWhileStmt(stmt).GetBody(), slices.Clone(localVars), env, visitedFuncs)
+		}
+		if varName != "" {
+			return varName
+		}
+
+		// Check the user defined functions called by the statement or passed as callback
+		for _, funcName := range findCalledFuncNames(stmt) {
+			if visitedFuncs[funcName] || slices.Contains(localVars, funcName) {
+				continue
+			}
+			if _, err := env.resolve(funcName); err == nil {
+				continue
+			}
+			caller, err := env.lookupFunc(funcName)
+			if err != nil || caller.GetType() != scrilaAst.FunctionValueType {
+				continue
+			}
+			visitedFuncs[funcName] = true
+			fn := runtimeToFuncVal(caller)
+			params := []string{}
+			for _, param := range fn.GetParams() {
+				params = append(params, param.GetName())
+			}
+			if varName = findChangedCapturedVarInBody(fn.GetBody(), params, fn.GetDeclarationEnv(), visitedFuncs); varName != "" {
+				return varName
+			}
+		}
+	}
+	return ""
+}
+
+// Returns the names of the identifiers of the given statement that can reference a function.
+// The bodies of nested statements are not included.
+func findCalledFuncNames(stmt scrilaAst.IStatement) []string {
+	names := []string{}
+	switch stmt.GetKind() {
+	case scrilaAst.IdentifierNode:
+		names = append(names, identNodeGetSymbol(stmt))
+	case scrilaAst.CallExprNode:
+		callExpr := scrilaAst.ExprToCallExpr(stmt)
+		names = append(names, findCalledFuncNames(callExpr.GetCaller())...)
+		for _, arg := range callExpr.GetArgs() {
+			names = append(names, findCalledFuncNames(arg)...)
+		}
+	case scrilaAst.BinaryExprNode:
+		binOp := scrilaAst.ExprToBinExpr(stmt)
+		names = append(names, findCalledFuncNames(binOp.GetLeft())...)
+		names = append(names, findCalledFuncNames(binOp.GetRight())...)
+	case scrilaAst.AssignmentExprNode:
+		assignment := scrilaAst.ExprToAssignmentExpr(stmt)
+		names = append(names, findCalledFuncNames(assignment.GetAssigne())...)
+		names = append(names, findCalledFuncNames(assignment.GetValue())...)
+	case scrilaAst.MemberExprNode:
+		memberExpr := scrilaAst.ExprToMemberExpr(stmt)
+		names = append(names, findCalledFuncNames(memberExpr.GetObject())...)
+		if memberExpr.IsComputed() {
+			names = append(names, findCalledFuncNames(memberExpr.GetProperty())...)
+		}
+	case scrilaAst.ArrayLiteralNode:
+		for _, value := range scrilaAst.ExprToArray(stmt).GetValues() {
+			names = append(names, findCalledFuncNames(value)...)
+		}
+	case scrilaAst.ObjectLiteralNode:
+		for _, property := range scrilaAst.ExprToObjLit(stmt).GetProperties() {
+			names = append(names, findCalledFuncNames(property.GetValue())...)
+		}
+	case scrilaAst.ReturnExprNode:
+		if returnExpr := scrilaAst.ExprToReturnExpr(stmt); !returnExpr.IsEmpty() {
+			names = append(names, findCalledFuncNames(returnExpr.GetValue())...)
+		}
+	case scrilaAst.VarDeclarationNode:
+		names = append(names, findCalledFuncNames(scrilaAst.ExprToVarDecl(stmt).GetValue())...)
+	case scrilaAst.ForStatementNode:
+		forStmt := scrilaAst.ExprToForStmt(stmt)
+		names = append(names, findCalledFuncNames(forStmt.GetArray())...)
+		if forStmt.GetLimit() != nil {
+			names = append(names, findCalledFuncNames(forStmt.GetLimit())...)
+		}
+	case scrilaAst.IfStatementNode:
+		for ifStmt := scrilaAst.ExprToIfStmt(stmt); ifStmt != nil; ifStmt = ifStmt.GetElse() {
+			if ifStmt.GetCondition() != nil {
+				names = append(names, findCalledFuncNames(ifStmt.GetCondition())...)
+			}
+		}
+	case scrilaAst.WhileStatementNode:
+		names = append(names, findCalledFuncNames(scrilaAst.ExprToWhileStmt(stmt).GetCondition())...)
+	}
+	return names
+}
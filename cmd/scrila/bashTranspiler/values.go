@@ -1,6 +1,7 @@
 package bashTranspiler
 
 import (
+	"ScriLa/cmd/scrila/bashAst"
 	"ScriLa/cmd/scrila/scrilaAst"
 	"fmt"
 )
@@ -42,6 +43,33 @@ func (self *NullVal) GetValue() string {
 	return self.value
 }
 
+// FloatVal
+
+type IFloatVal interface {
+	scrilaAst.IRuntimeVal
+	GetValue() float64
+}
+
+type FloatVal struct {
+	runtimeVal *scrilaAst.RuntimeVal
+	value      float64
+}
+
+func NewFloatVal(value float64) *FloatVal {
+	return &FloatVal{
+		runtimeVal: scrilaAst.NewRuntimeVal(scrilaAst.FloatValueType),
+		value:      value,
+	}
+}
+
+func (self *FloatVal) GetType() scrilaAst.ValueType {
+	return self.runtimeVal.GetType()
+}
+
+func (self *FloatVal) GetValue() float64 {
+	return self.value
+}
+
 // IntVal
 
 type IIntVal interface {
@@ -158,16 +186,28 @@ func (self *StrVal) GetValue() string {
 
 type FunctionCall func(args []scrilaAst.IExpr, env *Environment) (scrilaAst.IRuntimeVal, error)
 
+// Resolves the return type of a native function whose return type depends on the given args
+type ReturnTypeFn func(args []scrilaAst.IExpr, env *Environment) (scrilaAst.NodeType, error)
+
+// Returns the Bash statements that replace the call of an inline native function
+type InlineFn func(bashArgs []bashAst.IStatement) []bashAst.IStatement
+
 type INativeFunc interface {
 	scrilaAst.IRuntimeVal
 	GetCall() FunctionCall
 	GetReturnType() scrilaAst.NodeType
+	GetReturnTypeFn() ReturnTypeFn
+	GetInlineFn() InlineFn
+	IsGeneric() bool
+	IsInline() bool
 }
 
 type NativeFunc struct {
-	runtimeVal *scrilaAst.RuntimeVal
-	call       FunctionCall
-	returnType scrilaAst.NodeType
+	runtimeVal   *scrilaAst.RuntimeVal
+	call         FunctionCall
+	returnType   scrilaAst.NodeType
+	returnTypeFn ReturnTypeFn
+	inlineFn     InlineFn
 }
 
 func NewNativeFunc(function FunctionCall, returnType scrilaAst.NodeType) *NativeFunc {
@@ -178,6 +218,26 @@ func NewNativeFunc(function FunctionCall, returnType scrilaAst.NodeType) *Native
 	}
 }
 
+// Creates a native function whose return type is resolved from the args of each call
+func NewGenericNativeFunc(function FunctionCall, returnTypeFn ReturnTypeFn) *NativeFunc {
+	return &NativeFunc{
+		runtimeVal:   scrilaAst.NewRuntimeVal(scrilaAst.NativeFnType),
+		call:         function,
+		returnType:   scrilaAst.VoidNode,
+		returnTypeFn: returnTypeFn,
+	}
+}
+
+// Creates a native function whose Bash code is inserted at the call instead of calling a Bash function
+func NewInlineNativeFunc(function FunctionCall, inlineFn InlineFn, returnType scrilaAst.NodeType) *NativeFunc {
+	return &NativeFunc{
+		runtimeVal: scrilaAst.NewRuntimeVal(scrilaAst.NativeFnType),
+		call:       function,
+		returnType: returnType,
+		inlineFn:   inlineFn,
+	}
+}
+
 func (self *NativeFunc) GetType() scrilaAst.ValueType {
 	return self.runtimeVal.GetType()
 }
@@ -190,6 +250,22 @@ func (self *NativeFunc) GetReturnType() scrilaAst.NodeType {
 	return self.returnType
 }
 
+func (self *NativeFunc) GetReturnTypeFn() ReturnTypeFn {
+	return self.returnTypeFn
+}
+
+func (self *NativeFunc) GetInlineFn() InlineFn {
+	return self.inlineFn
+}
+
+func (self *NativeFunc) IsGeneric() bool {
+	return self.returnTypeFn != nil
+}
+
+func (self *NativeFunc) IsInline() bool {
+	return self.inlineFn != nil
+}
+
 // FunctionVal
 
 type IFunctionVal interface {
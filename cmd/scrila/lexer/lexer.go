@@ -122,6 +122,16 @@ func (self *Lexer) tokenizeInt() {
 	for self.isNotEof() && isDigit(self.at()) {
 		num += self.eat()
 	}
+
+	// A dot followed by a digit turns the int into a float e.g. 3.14
+	if self.isNotEof() && self.at() == "." && self.next(0) != "" && isDigit(self.next(0)) {
+		num += self.eat()
+		for self.isNotEof() && isDigit(self.at()) {
+			num += self.eat()
+		}
+		self.pushToken(num, Float)
+		return
+	}
 	self.pushToken(num, Int)
 }
 
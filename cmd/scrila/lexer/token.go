@@ -29,23 +29,26 @@ var singleCharTokens = map[string]TokenType{
 }
 
 var keywords = map[string]TokenType{
-	"bool":     BoolType,
-	"break":    Break,
-	"const":    Const,
-	"continue": Continue,
-	"else":     Else,
-	"false":    Bool,
-	"for":      For,
-	"func":     Function,
-	"if":       If,
-	"in":       In,
-	"int":      IntType,
-	"obj":      ObjType,
-	"return":   Return,
-	"str":      StrType,
-	"true":     Bool,
-	"void":     VoidType,
-	"while":    While,
+	"bool":       BoolType,
+	"break":      Break,
+	"const":      Const,
+	"continue":   Continue,
+	"else":       Else,
+	"execResult": ExecResultType,
+	"false":      Bool,
+	"float":      FloatType,
+	"for":        For,
+	"func":       Function,
+	"if":         If,
+	"in":         In,
+	"int":        IntType,
+	"job":        JobType,
+	"obj":        ObjType,
+	"return":     Return,
+	"str":        StrType,
+	"true":       Bool,
+	"void":       VoidType,
+	"while":      While,
 }
 
 type TokenType string
@@ -76,16 +79,20 @@ const (
 	CloseParen   TokenType = "CloseParen"   // )
 	EndOfFile    TokenType = "EOF"
 	// Variables
-	Identifier TokenType = "Identifier"
-	Bool       TokenType = "BoolValue"
-	BoolType   TokenType = "BoolType"
-	Const      TokenType = "Const"
-	Int        TokenType = "IntValue"
-	IntType    TokenType = "IntType"
-	ObjType    TokenType = "ObjType"
-	Str        TokenType = "StrValue"
-	StrType    TokenType = "StrType"
-	VoidType   TokenType = "VoidType"
+	Identifier     TokenType = "Identifier"
+	Bool           TokenType = "BoolValue"
+	BoolType       TokenType = "BoolType"
+	Const          TokenType = "Const"
+	ExecResultType TokenType = "ExecResultType"
+	Float          TokenType = "FloatValue"
+	FloatType      TokenType = "FloatType"
+	Int            TokenType = "IntValue"
+	IntType        TokenType = "IntType"
+	JobType        TokenType = "JobType"
+	ObjType        TokenType = "ObjType"
+	Str            TokenType = "StrValue"
+	StrType        TokenType = "StrType"
+	VoidType       TokenType = "VoidType"
 )
 
 type Token struct {
@@ -20,6 +20,7 @@ func main() {
 	showCallStackScrila := flag.Bool("scs", false, "Show call stack for ScriLa")
 	showCallStackBash := flag.Bool("scb", false, "Show call stack for Bash")
 	filename := flag.String("f", "", "Script file")
+	completion := flag.String("completion", "", "Generate a Bash completion for the given command name of the script")
 	flag.Parse()
 
 	// Check if filename is not empty
@@ -35,10 +36,10 @@ func main() {
 	config.ShowCallStackScrila = *showCallStackScrila
 	config.ShowCallStackBash = *showCallStackBash
 
-	transpile()
+	transpile(*completion)
 }
 
-func transpile() {
+func transpile(completion string) {
 	parser := parser.NewParser()
 	transpilerObj := bashTranspiler.NewTranspiler()
 	env := bashTranspiler.NewEnvironment(nil, transpilerObj)
@@ -80,4 +81,17 @@ func transpile() {
 		fmt.Println(err)
 		os.Exit(1)
 	}
+
+	if completion != "" {
+		completionProgram, err := transpilerObj.TranspileCompletion(completion)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		err = bashAssembler.NewAssembler().AssembleCompletion(completionProgram)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
 }
@@ -7,10 +7,13 @@ import (
 )
 
 var lexerTokenTypeToScrilaNodeTypeMapping = map[lexer.TokenType]scrilaAst.NodeType{
-	lexer.BoolType: scrilaAst.BoolLiteralNode,
-	lexer.IntType:  scrilaAst.IntLiteralNode,
-	lexer.StrType:  scrilaAst.StrLiteralNode,
-	lexer.VoidType: scrilaAst.VoidNode,
+	lexer.BoolType:       scrilaAst.BoolLiteralNode,
+	lexer.ExecResultType: scrilaAst.ExecResultNode,
+	lexer.FloatType:      scrilaAst.FloatLiteralNode,
+	lexer.IntType:        scrilaAst.IntLiteralNode,
+	lexer.JobType:        scrilaAst.JobNode,
+	lexer.StrType:        scrilaAst.StrLiteralNode,
+	lexer.VoidType:       scrilaAst.VoidNode,
 }
 
 func lexerTokenTypeToScrilaNodeType(tokenType lexer.TokenType) (scrilaAst.NodeType, error) {
@@ -12,6 +12,7 @@ import (
 
 var funcReturnTypes = []scrilaAst.NodeType{
 	scrilaAst.BoolArrayNode, scrilaAst.BoolLiteralNode,
+	scrilaAst.FloatArrayNode, scrilaAst.FloatLiteralNode,
 	scrilaAst.IntArrayNode, scrilaAst.IntLiteralNode,
 	scrilaAst.StrArrayNode, scrilaAst.StrLiteralNode,
 	scrilaAst.VoidNode,
@@ -60,13 +61,13 @@ func (self *Parser) parseStatement() (scrilaAst.IStatement, error) {
 	case lexer.Comment:
 		commentToken := self.eat()
 		return scrilaAst.NewComment(commentToken.Value, commentToken.Ln, commentToken.Col), nil
-	case lexer.Const, lexer.BoolType, lexer.IntType, lexer.StrType, lexer.ObjType:
+	case lexer.Const, lexer.BoolType, lexer.ExecResultType, lexer.FloatType, lexer.IntType, lexer.JobType, lexer.StrType, lexer.ObjType:
 		statement, err = self.parseVarDeclaration()
 		if err != nil {
 			return scrilaAst.NewEmptyStatement(), err
 		}
 	case lexer.For:
-		return self.parseForStatement()
+		return self.parseForStatement(false)
 	case lexer.If:
 		return self.parseIfStatement(false)
 	case lexer.While:
@@ -85,6 +86,10 @@ func (self *Parser) parseStatement() (scrilaAst.IStatement, error) {
 			return scrilaAst.NewEmptyStatement(), err
 		}
 	default:
+		// "parallel" is no keyword so that it can still be used as identifier
+		if self.at().TokenType == lexer.Identifier && self.at().Value == "parallel" && self.next(0).TokenType == lexer.For {
+			return self.parseForStatement(true)
+		}
 		statement, err = self.parseExpr()
 		if err != nil {
 			return scrilaAst.NewEmptyStatement(), err
@@ -102,7 +107,7 @@ func (self *Parser) parseVarDeclaration() (scrilaAst.IStatement, error) {
 		self.eat()
 	}
 
-	if !slices.Contains([]lexer.TokenType{lexer.BoolType, lexer.IntType, lexer.StrType}, self.at().TokenType) {
+	if !slices.Contains([]lexer.TokenType{lexer.BoolType, lexer.ExecResultType, lexer.FloatType, lexer.IntType, lexer.JobType, lexer.StrType}, self.at().TokenType) {
 		return scrilaAst.NewEmptyStatement(), fmt.Errorf("%s: Variable type '%s' not given or supported", self.getPos(self.at()), self.at().Value)
 	}
 	varType, err := lexerTokenTypeToScrilaNodeType(self.eat().TokenType)
@@ -217,7 +222,13 @@ func (self *Parser) parseIfStatement(isElse bool) (scrilaAst.IStatement, error)
 	return scrilaAst.NewIfStatement(condition, body, elseBlock, ifToken.Ln, ifToken.Col), nil
 }
 
-func (self *Parser) parseForStatement() (scrilaAst.IStatement, error) {
+// [parallel] for (TYPE IDENT in EXPR) [limit EXPR] [failFast] { BODY }
+// The options "limit" and "failFast" are only allowed for a parallel for loop.
+func (self *Parser) parseForStatement(isParallel bool) (scrilaAst.IStatement, error) {
+	parallelToken := self.at()
+	if isParallel {
+		self.eat()
+	}
 	forToken := self.eat()
 
 	// Condition wrapped in braces
@@ -226,7 +237,7 @@ func (self *Parser) parseForStatement() (scrilaAst.IStatement, error) {
 		return scrilaAst.NewEmptyStatement(), err
 	}
 	// Variable type
-	if !slices.Contains([]lexer.TokenType{lexer.BoolType, lexer.IntType, lexer.StrType}, self.at().TokenType) {
+	if !slices.Contains([]lexer.TokenType{lexer.BoolType, lexer.ExecResultType, lexer.FloatType, lexer.IntType, lexer.JobType, lexer.StrType}, self.at().TokenType) {
 		return scrilaAst.NewEmptyStatement(), fmt.Errorf("%s: Variable type '%s' not given or supported", self.getPos(self.at()), self.at().Value)
 	}
 	varType, err := lexerTokenTypeToScrilaNodeType(self.eat().TokenType)
@@ -254,6 +265,25 @@ func (self *Parser) parseForStatement() (scrilaAst.IStatement, error) {
 	if err != nil {
 		return scrilaAst.NewEmptyStatement(), err
 	}
+	// Options of a parallel for loop
+	var limit scrilaAst.IExpr = scrilaAst.NewEmptyExpr()
+	isFailFast := false
+	if isParallel {
+		if self.at().TokenType == lexer.Identifier && self.at().Value == "limit" {
+			self.eat()
+			if self.at().TokenType == lexer.OpenBrace {
+				return scrilaAst.NewEmptyStatement(), fmt.Errorf("%s: Expected limit of parallel for loop", self.getPos(self.at()))
+			}
+			limit, err = self.parseExpr()
+			if err != nil {
+				return scrilaAst.NewEmptyStatement(), err
+			}
+		}
+		if self.at().TokenType == lexer.Identifier && self.at().Value == "failFast" {
+			self.eat()
+			isFailFast = true
+		}
+	}
 
 	// Body
 	_, err = self.expect(lexer.OpenBrace, "Expected block following condition")
@@ -276,6 +306,9 @@ func (self *Parser) parseForStatement() (scrilaAst.IStatement, error) {
 		return scrilaAst.NewEmptyStatement(), err
 	}
 
+	if isParallel {
+		return scrilaAst.NewParallelForStatement(varType, scrilaAst.NewIdentifier(identifier, token.Ln, token.Col), array, body, limit, isFailFast, parallelToken.Ln, parallelToken.Col), nil
+	}
 	return scrilaAst.NewForStatement(varType, scrilaAst.NewIdentifier(identifier, token.Ln, token.Col), array, body, forToken.Ln, forToken.Col), nil
 }
 
@@ -605,15 +638,24 @@ func (self *Parser) parseParams() ([]*scrilaAst.Parameter, error) {
 func (self *Parser) parseParametersList() ([]*scrilaAst.Parameter, error) {
 	params := make([]*scrilaAst.Parameter, 0)
 
-	if !slices.Contains([]lexer.TokenType{lexer.StrType, lexer.BoolType, lexer.IntType, lexer.ObjType}, self.at().TokenType) {
+	if !slices.Contains([]lexer.TokenType{lexer.StrType, lexer.BoolType, lexer.FloatType, lexer.IntType, lexer.JobType, lexer.ObjType}, self.at().TokenType) {
 		return params, fmt.Errorf("%s: Expected param type but got %s '%s'", self.getPos(self.at()), self.at().TokenType, self.at().Value)
 	}
 
-	for self.notEOF() && slices.Contains([]lexer.TokenType{lexer.StrType, lexer.BoolType, lexer.IntType, lexer.ObjType}, self.at().TokenType) {
+	for self.notEOF() && slices.Contains([]lexer.TokenType{lexer.StrType, lexer.BoolType, lexer.FloatType, lexer.IntType, lexer.JobType, lexer.ObjType}, self.at().TokenType) {
 		paramType, err := lexerTokenTypeToScrilaNodeType(self.eat().TokenType)
 		if err != nil {
 			return params, err
 		}
+		// Change param type to the array data type equivalent
+		if self.at().TokenType == lexer.OpenBracket && self.next(0).TokenType == lexer.CloseBracket {
+			self.eat()
+			self.eat()
+			paramType, err = scrilaAst.DataTypeToArrayType(paramType)
+			if err != nil {
+				return params, err
+			}
+		}
 		ident, err := self.expect(lexer.Identifier, "parseParametersList: Expected identifier following param type")
 		if err != nil {
 			return params, err
@@ -679,8 +721,17 @@ func (self *Parser) parseMemberExpr() (scrilaAst.IExpr, error) {
 		return scrilaAst.NewEmptyExpr(), err
 	}
 
-	for self.at().TokenType == lexer.OpenBracket {
-		self.eat()
+	for self.at().TokenType == lexer.OpenBracket || self.at().TokenType == lexer.Dot {
+		// Field access: obj.field
+		if self.eat().TokenType == lexer.Dot {
+			fieldToken, err := self.expect(lexer.Identifier, "Expected field name following the dot")
+			if err != nil {
+				return scrilaAst.NewEmptyExpr(), err
+			}
+			property := scrilaAst.NewIdentifier(fieldToken.Value, fieldToken.Ln, fieldToken.Col)
+			object = scrilaAst.NewMemberExpr(object, property, false, false)
+			continue
+		}
 
 		isEmpty := self.at().TokenType == lexer.CloseBracket
 		var property scrilaAst.IExpr = scrilaAst.NewEmptyExpr()
@@ -698,7 +749,7 @@ func (self *Parser) parseMemberExpr() (scrilaAst.IExpr, error) {
 			return scrilaAst.NewEmptyExpr(), err
 		}
 
-		object = scrilaAst.NewMemberExpr(object, property, isEmpty)
+		object = scrilaAst.NewMemberExpr(object, property, isEmpty, true)
 	}
 
 	return object, nil
@@ -716,6 +767,13 @@ func (self *Parser) parsePrimaryExpr() (scrilaAst.IExpr, error) {
 			return scrilaAst.NewEmptyExpr(), err
 		}
 		return scrilaAst.NewIntLiteral(intValue, intToken.Ln, intToken.Col), nil
+	case lexer.Float:
+		floatToken := self.eat()
+		floatValue, err := strconv.ParseFloat(floatToken.Value, 64)
+		if err != nil {
+			return scrilaAst.NewEmptyExpr(), err
+		}
+		return scrilaAst.NewFloatLiteral(floatValue, floatToken.Ln, floatToken.Col), nil
 	case lexer.Str:
 		strToken := self.eat()
 		return scrilaAst.NewStrLiteral(strToken.Value, strToken.Ln, strToken.Col), nil
@@ -29,13 +29,18 @@ const (
 	ObjectLiteralNode NodeType = "ObjectLiteral"
 	IdentifierNode    NodeType = "Identifier"
 	ArrayLiteralNode  NodeType = "Array"
-	IntLiteralNode    NodeType = "IntLiteral"  // Also data type
-	StrLiteralNode    NodeType = "StrLiteral"  // Also data type
-	BoolLiteralNode   NodeType = "BoolLiteral" // Also data type
+	FloatLiteralNode  NodeType = "FloatLiteral" // Also data type
+	IntLiteralNode    NodeType = "IntLiteral"   // Also data type
+	StrLiteralNode    NodeType = "StrLiteral"   // Also data type
+	BoolLiteralNode   NodeType = "BoolLiteral"  // Also data type
 
 	// Data types
-	VoidNode      NodeType = "Void"
-	BoolArrayNode NodeType = "BoolArray"
-	IntArrayNode  NodeType = "IntArray"
-	StrArrayNode  NodeType = "StrArray"
+	VoidNode       NodeType = "Void"
+	BoolArrayNode  NodeType = "BoolArray"
+	FloatArrayNode NodeType = "FloatArray"
+	IntArrayNode   NodeType = "IntArray"
+	StrArrayNode   NodeType = "StrArray"
+	ExecResultNode NodeType = "ExecResult"
+	JobNode        NodeType = "Job"
+	JobArrayNode   NodeType = "JobArray"
 )
@@ -83,6 +83,11 @@ func ExprToBoolLit(expr IExpr) IBoolLiteral {
 	return i.(IBoolLiteral)
 }
 
+func ExprToFloatLit(expr IExpr) IFloatLiteral {
+	var i interface{} = expr
+	return i.(IFloatLiteral)
+}
+
 func ExprToIntLit(expr IExpr) IIntLiteral {
 	var i interface{} = expr
 	return i.(IIntLiteral)
@@ -99,9 +104,11 @@ func ExprToObjLit(expr IExpr) IObjectLiteral {
 }
 
 var valueTypeToArrayMapping = map[ValueType]ValueType{
-	BoolValueType: BoolArrayValueType,
-	IntValueType:  IntArrayValueType,
-	StrValueType:  StrArrayValueType,
+	BoolValueType:  BoolArrayValueType,
+	FloatValueType: FloatArrayValueType,
+	IntValueType:   IntArrayValueType,
+	JobValueType:   JobArrayValueType,
+	StrValueType:   StrArrayValueType,
 }
 
 func ValueTypeToArrayType(valueType ValueType) (ValueType, error) {
@@ -122,10 +129,12 @@ func ArrayTypeToValueType(arrayType ValueType) (ValueType, error) {
 }
 
 var dataTypeToArrayMapping = map[NodeType]NodeType{
-	BoolLiteralNode: BoolArrayNode,
-	IntLiteralNode:  IntArrayNode,
-	StrLiteralNode:  StrArrayNode,
-	VoidNode:        VoidNode,
+	BoolLiteralNode:  BoolArrayNode,
+	FloatLiteralNode: FloatArrayNode,
+	IntLiteralNode:   IntArrayNode,
+	JobNode:          JobArrayNode,
+	StrLiteralNode:   StrArrayNode,
+	VoidNode:         VoidNode,
 }
 
 func DataTypeToArrayType(dataType NodeType) (NodeType, error) {
@@ -273,28 +273,31 @@ type IMemberExpr interface {
 	GetObject() IExpr
 	GetProperty() IExpr
 	IsEmpty() bool
+	IsComputed() bool
 }
 
 type MemberExpr struct {
-	expr     *Expr
-	object   IExpr
-	property IExpr
-	isEmpty  bool
+	expr       *Expr
+	object     IExpr
+	property   IExpr
+	isEmpty    bool
+	isComputed bool
 }
 
 func (self *MemberExpr) String() string {
 	indentDepth++
-	str := fmt.Sprintf("{%s - id: %d, isEmpty: %t,\n%sobject: %s,\n%sproperty: %s}", self.GetKind(), self.GetId(), self.IsEmpty(), indent(), self.GetObject(), indent(), self.GetProperty())
+	str := fmt.Sprintf("{%s - id: %d, isEmpty: %t, isComputed: %t,\n%sobject: %s,\n%sproperty: %s}", self.GetKind(), self.GetId(), self.IsEmpty(), self.IsComputed(), indent(), self.GetObject(), indent(), self.GetProperty())
 	indentDepth--
 	return str
 }
 
-func NewMemberExpr(object IExpr, property IExpr, isEmpty bool) *MemberExpr {
+func NewMemberExpr(object IExpr, property IExpr, isEmpty bool, isComputed bool) *MemberExpr {
 	return &MemberExpr{
-		expr:     NewExpr(MemberExprNode, 0, 0),
-		object:   object,
-		property: property,
-		isEmpty:  isEmpty,
+		expr:       NewExpr(MemberExprNode, object.GetLn(), object.GetCol()),
+		object:     object,
+		property:   property,
+		isEmpty:    isEmpty,
+		isComputed: isComputed,
 	}
 }
 
@@ -318,6 +321,10 @@ func (self *MemberExpr) IsEmpty() bool {
 	return self.isEmpty
 }
 
+func (self *MemberExpr) IsComputed() bool {
+	return self.isComputed
+}
+
 func (self *MemberExpr) GetLn() int {
 	return self.expr.GetLn()
 }
@@ -117,6 +117,60 @@ func (self *BoolLiteral) SetResult(value IRuntimeVal) {
 	self.expr.SetResult(value)
 }
 
+// FloatLiteral
+
+type IFloatLiteral interface {
+	IExpr
+	GetValue() float64
+}
+
+type FloatLiteral struct {
+	expr  *Expr
+	value float64
+}
+
+func (self *FloatLiteral) String() string {
+	indentDepth++
+	str := fmt.Sprintf("{%s - id: %d, value: %g}", self.GetKind(), self.GetId(), self.GetValue())
+	indentDepth--
+	return str
+}
+
+func NewFloatLiteral(value float64, ln int, col int) *FloatLiteral {
+	return &FloatLiteral{
+		expr:  NewExpr(FloatLiteralNode, ln, col),
+		value: value,
+	}
+}
+
+func (self *FloatLiteral) GetId() int {
+	return self.expr.GetId()
+}
+
+func (self *FloatLiteral) GetKind() NodeType {
+	return self.expr.GetKind()
+}
+
+func (self *FloatLiteral) GetValue() float64 {
+	return self.value
+}
+
+func (self *FloatLiteral) GetLn() int {
+	return self.expr.GetLn()
+}
+
+func (self *FloatLiteral) GetCol() int {
+	return self.expr.GetCol()
+}
+
+func (self *FloatLiteral) GetResult() IRuntimeVal {
+	return self.expr.GetResult()
+}
+
+func (self *FloatLiteral) SetResult(value IRuntimeVal) {
+	self.expr.SetResult(value)
+}
+
 // IntLiteral
 
 type IIntLiteral interface {
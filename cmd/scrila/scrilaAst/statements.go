@@ -247,6 +247,9 @@ type IForStatement interface {
 	GetIndex() IIdentifier
 	GetArray() IExpr
 	GetBody() []IStatement
+	IsParallel() bool
+	GetLimit() IExpr
+	IsFailFast() bool
 }
 
 type ForStatement struct {
@@ -255,11 +258,17 @@ type ForStatement struct {
 	index        IIdentifier
 	array        IExpr
 	body         []IStatement
+	isParallel   bool
+	limit        IExpr
+	isFailFast   bool
 }
 
 func (self *ForStatement) String() string {
 	indentDepth++
 	str := fmt.Sprintf("{%s - id: %d, index var type: %s,\n%sindex: %s\n%sarray: %s", self.GetKind(), self.GetId(), self.GetIndexVarType(), indent(), self.GetIndex(), indent(), self.GetArray())
+	if self.IsParallel() {
+		str += fmt.Sprintf("\n%sisFailFast: %t, limit: %s", indent(), self.IsFailFast(), self.GetLimit())
+	}
 	if len(self.GetBody()) > 0 {
 		str += fmt.Sprintf("\n%sbody:", indent())
 		indentDepth++
@@ -279,9 +288,20 @@ func NewForStatement(indexVarType NodeType, index IIdentifier, array IExpr, body
 		index:        index,
 		array:        array,
 		body:         body,
+		limit:        NewEmptyExpr(),
 	}
 }
 
+// Creates a for statement whose iterations run as background jobs.
+// The limit is the maximum number of iterations running at the same time. An empty expr means no limit.
+func NewParallelForStatement(indexVarType NodeType, index IIdentifier, array IExpr, body []IStatement, limit IExpr, isFailFast bool, ln int, col int) *ForStatement {
+	forStmt := NewForStatement(indexVarType, index, array, body, ln, col)
+	forStmt.isParallel = true
+	forStmt.limit = limit
+	forStmt.isFailFast = isFailFast
+	return forStmt
+}
+
 func (self *ForStatement) GetId() int {
 	return self.statement.GetId()
 }
@@ -306,6 +326,18 @@ func (self *ForStatement) GetBody() []IStatement {
 	return self.body
 }
 
+func (self *ForStatement) IsParallel() bool {
+	return self.isParallel
+}
+
+func (self *ForStatement) GetLimit() IExpr {
+	return self.limit
+}
+
+func (self *ForStatement) IsFailFast() bool {
+	return self.isFailFast
+}
+
 func (self *ForStatement) GetLn() int {
 	return self.statement.GetLn()
 }
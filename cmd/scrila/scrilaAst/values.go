@@ -3,26 +3,36 @@ package scrilaAst
 type ValueType string
 
 const (
-	BoolArrayValueType ValueType = "bool-array"
-	BoolValueType      ValueType = "bool"
-	FunctionValueType  ValueType = "function"
-	IntArrayValueType  ValueType = "int-array"
-	IntValueType       ValueType = "int"
-	NativeFnType       ValueType = "native-func"
-	NullValueType      ValueType = "null"
-	ObjValueType       ValueType = "obj"
-	StrArrayValueType  ValueType = "str-array"
-	StrValueType       ValueType = "str"
+	BoolArrayValueType  ValueType = "bool-array"
+	BoolValueType       ValueType = "bool"
+	ExecResultValueType ValueType = "exec-result"
+	FloatArrayValueType ValueType = "float-array"
+	FloatValueType      ValueType = "float"
+	FunctionValueType   ValueType = "function"
+	IntArrayValueType   ValueType = "int-array"
+	IntValueType        ValueType = "int"
+	JobArrayValueType   ValueType = "job-array"
+	JobValueType        ValueType = "job"
+	NativeFnType        ValueType = "native-func"
+	NullValueType       ValueType = "null"
+	ObjValueType        ValueType = "obj"
+	StrArrayValueType   ValueType = "str-array"
+	StrValueType        ValueType = "str"
 )
 
 var nodeTypeValueTypeMapping = map[ValueType]NodeType{
-	BoolArrayValueType: BoolArrayNode,
-	BoolValueType:      BoolLiteralNode,
-	IntArrayValueType:  IntArrayNode,
-	IntValueType:       IntLiteralNode,
-	ObjValueType:       ObjectLiteralNode,
-	StrArrayValueType:  StrArrayNode,
-	StrValueType:       StrLiteralNode,
+	BoolArrayValueType:  BoolArrayNode,
+	BoolValueType:       BoolLiteralNode,
+	ExecResultValueType: ExecResultNode,
+	FloatArrayValueType: FloatArrayNode,
+	FloatValueType:      FloatLiteralNode,
+	IntArrayValueType:   IntArrayNode,
+	IntValueType:        IntLiteralNode,
+	JobArrayValueType:   JobArrayNode,
+	JobValueType:        JobNode,
+	ObjValueType:        ObjectLiteralNode,
+	StrArrayValueType:   StrArrayNode,
+	StrValueType:        StrLiteralNode,
 }
 
 func DoTypesMatch(type1 NodeType, type2 ValueType) bool {